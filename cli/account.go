@@ -0,0 +1,253 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// GetAccounts - Get Honeybadger Accounts
+func (hbc *HoneybadgerClient) GetAccounts() ([]HoneybadgerAccount, error) {
+	var hbAccounts HoneybadgerAccounts
+
+	url := fmt.Sprintf("%s/v2/accounts", hbc.HostURL)
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return hbAccounts.Accounts, err
+	}
+
+	body, err := hbc.DoRequest(req)
+	if err != nil {
+		return hbAccounts.Accounts, err
+	}
+
+	err = json.Unmarshal(body, &hbAccounts)
+	if err != nil {
+		return hbAccounts.Accounts, err
+	}
+
+	return hbAccounts.Accounts, nil
+}
+
+// FindAccountByID - Find Account by ID
+func (hbc *HoneybadgerClient) FindAccountByID(accountID string) (HoneybadgerAccount, error) {
+	hbAccounts, err := hbc.GetAccounts()
+	if err != nil {
+		return HoneybadgerAccount{}, err
+	}
+
+	for _, account := range hbAccounts {
+		if account.ID == accountID {
+			return account, nil
+		}
+	}
+	return HoneybadgerAccount{}, errors.New("Account not found")
+}
+
+// GetAccountUsers - Get users that belong to an Account, following pagination
+func (hbc *HoneybadgerClient) GetAccountUsers(accountID string) ([]HoneybadgerAccountUser, error) {
+	var hbUserList []HoneybadgerAccountUser
+
+	pageURL := fmt.Sprintf("%s/v2/accounts/%s/users", hbc.HostURL, accountID)
+	for pageURL != "" {
+		var hbUsers HoneybadgerAccountUsers
+
+		req, err := http.NewRequest("GET", pageURL, nil)
+		if err != nil {
+			return hbUserList, err
+		}
+
+		body, err := hbc.DoRequest(req)
+		if err != nil {
+			return hbUserList, err
+		}
+
+		err = json.Unmarshal(body, &hbUsers)
+		if err != nil {
+			return hbUserList, err
+		}
+
+		hbUserList = append(hbUserList, hbUsers.Users...)
+
+		pageURL = ""
+		if hbUsers.Links.NextPage != "" {
+			pageURL = hbc.pageURL(hbUsers.Links.NextPage)
+		}
+	}
+
+	return hbUserList, nil
+}
+
+// UpdateAccountUser - Update the role of an Account user
+func (hbc *HoneybadgerClient) UpdateAccountUser(accountID string, userID int, role string) error {
+	var jsonPayload = []byte(`{"user":{"role":"` + accountRole(role) + `"}}`)
+
+	url := fmt.Sprintf("%s/v2/accounts/%s/users/%d", hbc.HostURL, accountID, userID)
+	req, err := http.NewRequest("PUT", url, bytes.NewBuffer(jsonPayload))
+	if err != nil {
+		return err
+	}
+
+	_, err = hbc.DoRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteAccountUser - Remove a user from an Account
+func (hbc *HoneybadgerClient) DeleteAccountUser(accountID string, userID int) error {
+	url := fmt.Sprintf("%s/v2/accounts/%s/users/%d", hbc.HostURL, accountID, userID)
+	req, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = hbc.DoRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetAccountInvitations - Get pending invitations of an Account, following pagination
+func (hbc *HoneybadgerClient) GetAccountInvitations(accountID string) ([]HoneybadgerInvitation, error) {
+	var hbInvitationList []HoneybadgerInvitation
+
+	pageURL := fmt.Sprintf("%s/v2/accounts/%s/invitations", hbc.HostURL, accountID)
+	for pageURL != "" {
+		var hbInvitations HoneybadgerAccountInvitations
+
+		req, err := http.NewRequest("GET", pageURL, nil)
+		if err != nil {
+			return hbInvitationList, err
+		}
+
+		body, err := hbc.DoRequest(req)
+		if err != nil {
+			return hbInvitationList, err
+		}
+
+		err = json.Unmarshal(body, &hbInvitations)
+		if err != nil {
+			return hbInvitationList, err
+		}
+
+		hbInvitationList = append(hbInvitationList, hbInvitations.Invitations...)
+
+		pageURL = ""
+		if hbInvitations.Links.NextPage != "" {
+			pageURL = hbc.pageURL(hbInvitations.Links.NextPage)
+		}
+	}
+
+	return hbInvitationList, nil
+}
+
+// CreateAccountInvitation - Invite a user to an Account
+func (hbc *HoneybadgerClient) CreateAccountInvitation(accountID string, userEmail string, role string) (HoneybadgerInvitation, error) {
+	var hbInvitation HoneybadgerInvitation
+	var jsonPayload = []byte(`{"invitation":{"email":"` + userEmail + `", "role":"` + accountRole(role) + `"}}`)
+
+	url := fmt.Sprintf("%s/v2/accounts/%s/invitations", hbc.HostURL, accountID)
+	req, err := http.NewRequest("POST", url, bytes.NewBuffer(jsonPayload))
+	if err != nil {
+		return HoneybadgerInvitation{}, err
+	}
+
+	body, err := hbc.DoRequest(req)
+	if err != nil {
+		return HoneybadgerInvitation{}, err
+	}
+
+	err = json.Unmarshal(body, &hbInvitation)
+	if err != nil {
+		return HoneybadgerInvitation{}, err
+	}
+
+	return hbInvitation, nil
+}
+
+// UpdateAccountInvitation - Update the role of a pending Account invitation
+func (hbc *HoneybadgerClient) UpdateAccountInvitation(accountID string, invitationID int, role string) error {
+	var jsonPayload = []byte(`{"invitation":{"role":"` + accountRole(role) + `"}}`)
+
+	url := fmt.Sprintf("%s/v2/accounts/%s/invitations/%d", hbc.HostURL, accountID, invitationID)
+	req, err := http.NewRequest("PUT", url, bytes.NewBuffer(jsonPayload))
+	if err != nil {
+		return err
+	}
+
+	_, err = hbc.DoRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteAccountInvitation - Revoke a pending Account invitation
+func (hbc *HoneybadgerClient) DeleteAccountInvitation(accountID string, invitationID int) error {
+	url := fmt.Sprintf("%s/v2/accounts/%s/invitations/%d", hbc.HostURL, accountID, invitationID)
+	req, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = hbc.DoRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ErrAccountUserNotFound - The user is neither a member of the account nor invited to it
+var ErrAccountUserNotFound = errors.New("user not found in account")
+
+// GetAccountUserByEmail - Get an Account user, or its pending invitation, by email
+func (hbc *HoneybadgerClient) GetAccountUserByEmail(accountID string, userEmail string) (HoneybadgerAccountUser, error) {
+	users, err := hbc.GetAccountUsers(accountID)
+	if err != nil {
+		return HoneybadgerAccountUser{}, err
+	}
+
+	for _, user := range users {
+		if strings.EqualFold(user.Email, userEmail) {
+			return user, nil
+		}
+	}
+
+	// The user has not accepted the invitation yet, so it is not in the user list
+	invitations, err := hbc.GetAccountInvitations(accountID)
+	if err != nil {
+		return HoneybadgerAccountUser{}, err
+	}
+
+	for _, invitation := range invitations {
+		if strings.EqualFold(invitation.Email, userEmail) {
+			return HoneybadgerAccountUser{
+				ID:        invitation.ID,
+				Email:     invitation.Email,
+				Role:      invitation.Role,
+				CreatedAt: invitation.CreatedAt,
+				IsPending: true,
+			}, nil
+		}
+	}
+
+	return HoneybadgerAccountUser{}, fmt.Errorf("User %s not found in account %s: %w", userEmail, accountID, ErrAccountUserNotFound)
+}
+
+// accountRole - Honeybadger expects capitalized role names (Owner, Admin, Member)
+func accountRole(role string) string {
+	if role == "" {
+		return role
+	}
+	return strings.ToUpper(role[:1]) + strings.ToLower(role[1:])
+}
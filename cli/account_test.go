@@ -0,0 +1,285 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+	"net/http"
+	"testing"
+)
+
+var honeybadgerAccountID = "abcdef"
+
+func TestGetAccountsNotProperlyAnswering(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+	urlPath := "/v2/accounts"
+
+	expectedResponse := HoneybadgerAccounts{}
+	expectedErrorResponse := errors.New(`status: 500, body: {"results":null,"links":{"self":"","prev":"","next":""}}`)
+	expectedBody, _ := json.Marshal(expectedResponse)
+	gock.New(honeybadgerAPIHost).
+		Get(urlPath).
+		Reply(http.StatusInternalServerError).
+		JSON(expectedBody)
+
+	actualResponse, actualErrResponse := honeybadgerCli.GetAccounts()
+
+	assert.Equal(expectedResponse.Accounts, actualResponse, "Actual response is different from expected response")
+	assert.Equal(actualErrResponse, expectedErrorResponse, "Reponse error must be 500")
+}
+
+func TestFindAccountByID(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+	urlPath := "/v2/accounts"
+
+	expectedResponse := HoneybadgerAccounts{
+		Accounts: []HoneybadgerAccount{
+			{
+				ID:       honeybadgerAccountID,
+				Name:     "Sequra",
+				Email:    "test.sequra@sequra.es",
+				IsActive: true,
+			},
+		},
+	}
+	expectedBody, _ := json.Marshal(expectedResponse)
+	gock.New(honeybadgerAPIHost).
+		Get(urlPath).
+		Reply(http.StatusOK).
+		JSON(expectedBody)
+
+	actualResponse, actualErrResponse := honeybadgerCli.FindAccountByID(honeybadgerAccountID)
+
+	assert.Equal(expectedResponse.Accounts[0], actualResponse, "Actual response is different from expected response")
+	assert.Equal(actualErrResponse, nil, "Reponse error must be nil")
+}
+
+func TestCreateAccountInvitation(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+	urlPath := fmt.Sprintf("/v2/accounts/%s/invitations", honeybadgerAccountID)
+
+	expectedResponse := HoneybadgerInvitation{
+		ID:    9,
+		Email: "new.user@sequra.es",
+		Role:  "Admin",
+	}
+	expectedBody, _ := json.Marshal(expectedResponse)
+	gock.New(honeybadgerAPIHost).
+		Post(urlPath).
+		BodyString(`{"invitation":{"email":"new.user@sequra.es", "role":"Admin"}}`).
+		Reply(http.StatusCreated).
+		JSON(expectedBody)
+
+	actualResponse, actualErrResponse := honeybadgerCli.CreateAccountInvitation(honeybadgerAccountID, "new.user@sequra.es", "admin")
+
+	assert.Equal(expectedResponse, actualResponse, "Actual response is different from expected response")
+	assert.Equal(actualErrResponse, nil, "Reponse error must be nil")
+}
+
+func TestUpdateAccountUser(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+	userID := 999
+	urlPath := fmt.Sprintf("/v2/accounts/%s/users/%d", honeybadgerAccountID, userID)
+
+	expectedBody, _ := json.Marshal(nil)
+	gock.New(honeybadgerAPIHost).
+		Put(urlPath).
+		BodyString(`{"user":{"role":"Member"}}`).
+		Reply(http.StatusNoContent).
+		JSON(expectedBody)
+
+	errResponse := honeybadgerCli.UpdateAccountUser(honeybadgerAccountID, userID, "member")
+
+	assert.Equal(errResponse, nil, "Reponse error must be nil")
+}
+
+func TestDeleteAccountUser(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+	userID := 999
+	urlPath := fmt.Sprintf("/v2/accounts/%s/users/%d", honeybadgerAccountID, userID)
+
+	expectedBody, _ := json.Marshal(nil)
+	gock.New(honeybadgerAPIHost).
+		Delete(urlPath).
+		Reply(http.StatusNoContent).
+		JSON(expectedBody)
+
+	errResponse := honeybadgerCli.DeleteAccountUser(honeybadgerAccountID, userID)
+
+	assert.Equal(errResponse, nil, "Reponse error must be nil")
+}
+
+func TestDeleteAccountInvitation(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+	invitationID := 9
+	urlPath := fmt.Sprintf("/v2/accounts/%s/invitations/%d", honeybadgerAccountID, invitationID)
+
+	expectedBody, _ := json.Marshal(nil)
+	gock.New(honeybadgerAPIHost).
+		Delete(urlPath).
+		Reply(http.StatusNoContent).
+		JSON(expectedBody)
+
+	errResponse := honeybadgerCli.DeleteAccountInvitation(honeybadgerAccountID, invitationID)
+
+	assert.Equal(errResponse, nil, "Reponse error must be nil")
+}
+
+func TestGetAccountUserByEmail(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+
+	mockUsersResponse := HoneybadgerAccountUsers{
+		Users: []HoneybadgerAccountUser{
+			{
+				ID:    991,
+				Name:  "Test Sequra",
+				Email: "test.sequra@sequra.es",
+				Role:  "Owner",
+			},
+		},
+	}
+	mockInvitationsResponse := HoneybadgerAccountInvitations{
+		Invitations: []HoneybadgerInvitation{
+			{
+				ID:    9,
+				Email: "test.sequra.invitation@sequra.es",
+				Role:  "Member",
+			},
+		},
+	}
+	usersBody, _ := json.Marshal(mockUsersResponse)
+	invitationsBody, _ := json.Marshal(mockInvitationsResponse)
+
+	expectedResponses := []struct {
+		response HoneybadgerAccountUser
+		email    string
+		err      error
+	}{
+		{
+			email:    "test.sequra@sequra.es",
+			response: mockUsersResponse.Users[0],
+		},
+		{
+			email: "test.sequra.invitation@sequra.es",
+			response: HoneybadgerAccountUser{
+				ID:        9,
+				Email:     "test.sequra.invitation@sequra.es",
+				Role:      "Member",
+				IsPending: true,
+			},
+		},
+		{
+			email: "not.found@sequra.es",
+			err:   ErrAccountUserNotFound,
+		},
+	}
+
+	for _, expectedResponse := range expectedResponses {
+		gock.New(honeybadgerAPIHost).
+			Get(fmt.Sprintf("/v2/accounts/%s/users", honeybadgerAccountID)).
+			Reply(http.StatusOK).
+			JSON(usersBody)
+		gock.New(honeybadgerAPIHost).
+			Get(fmt.Sprintf("/v2/accounts/%s/invitations", honeybadgerAccountID)).
+			Reply(http.StatusOK).
+			JSON(invitationsBody)
+
+		actualResponse, actualErrResponse := honeybadgerCli.GetAccountUserByEmail(honeybadgerAccountID, expectedResponse.email)
+		assert.Equal(expectedResponse.response, actualResponse, "Actual response is different from expected response")
+		if expectedResponse.err == nil {
+			assert.Nil(actualErrResponse, "Reponse error must be nil")
+		} else {
+			assert.ErrorIs(actualErrResponse, expectedResponse.err, "Reponse error does not match")
+		}
+		gock.Off()
+	}
+}
+
+func TestGetAccountUsersWithPagination(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+
+	pages := []struct {
+		urlPath string
+		hbUsers HoneybadgerAccountUsers
+	}{
+		{
+			urlPath: fmt.Sprintf("/v2/accounts/%s/users", honeybadgerAccountID),
+			hbUsers: HoneybadgerAccountUsers{
+				Users: []HoneybadgerAccountUser{{ID: 991, Email: "test.sequra.page1@sequra.es", Role: "Owner"}},
+				Links: HoneybadgerLink{NextPage: "/page2"},
+			},
+		},
+		{
+			urlPath: "/page2",
+			hbUsers: HoneybadgerAccountUsers{
+				Users: []HoneybadgerAccountUser{{ID: 992, Email: "test.sequra.page2@sequra.es", Role: "Member"}},
+			},
+		},
+	}
+
+	var expectedResponse []HoneybadgerAccountUser
+	for _, page := range pages {
+		pageBody, _ := json.Marshal(page.hbUsers)
+		gock.New(honeybadgerAPIHost).
+			Get(page.urlPath).
+			Reply(http.StatusOK).
+			JSON(pageBody)
+
+		expectedResponse = append(expectedResponse, page.hbUsers.Users...)
+	}
+
+	actualResponse, actualErrResponse := honeybadgerCli.GetAccountUsers(honeybadgerAccountID)
+
+	assert.Equal(expectedResponse, actualResponse, "Actual response is different from expected response")
+	assert.Equal(actualErrResponse, nil, "Reponse error must be nil")
+}
+
+func TestGetAccountInvitationsWithPagination(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+
+	pages := []struct {
+		urlPath       string
+		hbInvitations HoneybadgerAccountInvitations
+	}{
+		{
+			urlPath: fmt.Sprintf("/v2/accounts/%s/invitations", honeybadgerAccountID),
+			hbInvitations: HoneybadgerAccountInvitations{
+				Invitations: []HoneybadgerInvitation{{ID: 9, Email: "test.sequra.page1@sequra.es", Role: "Member"}},
+				Links:       HoneybadgerLink{NextPage: "/page2"},
+			},
+		},
+		{
+			urlPath: "/page2",
+			hbInvitations: HoneybadgerAccountInvitations{
+				Invitations: []HoneybadgerInvitation{{ID: 10, Email: "test.sequra.page2@sequra.es", Role: "Admin"}},
+			},
+		},
+	}
+
+	var expectedResponse []HoneybadgerInvitation
+	for _, page := range pages {
+		pageBody, _ := json.Marshal(page.hbInvitations)
+		gock.New(honeybadgerAPIHost).
+			Get(page.urlPath).
+			Reply(http.StatusOK).
+			JSON(pageBody)
+
+		expectedResponse = append(expectedResponse, page.hbInvitations.Invitations...)
+	}
+
+	actualResponse, actualErrResponse := honeybadgerCli.GetAccountInvitations(honeybadgerAccountID)
+
+	assert.Equal(expectedResponse, actualResponse, "Actual response is different from expected response")
+	assert.Equal(actualErrResponse, nil, "Reponse error must be nil")
+}
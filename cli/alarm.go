@@ -0,0 +1,96 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// GetAlarm - Get an Insights alarm of a project
+func (hbc *HoneybadgerClient) GetAlarm(projectID int, alarmID int) (HoneybadgerAlarm, error) {
+	var hbAlarm HoneybadgerAlarm
+
+	url := fmt.Sprintf("%s/v2/projects/%d/alarms/%d", hbc.HostURL, projectID, alarmID)
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return HoneybadgerAlarm{}, err
+	}
+
+	body, err := hbc.DoRequest(req)
+	if err != nil {
+		return HoneybadgerAlarm{}, err
+	}
+
+	err = json.Unmarshal(body, &hbAlarm)
+	if err != nil {
+		return HoneybadgerAlarm{}, err
+	}
+
+	return hbAlarm, nil
+}
+
+// CreateAlarm - Create an Insights alarm in a project
+func (hbc *HoneybadgerClient) CreateAlarm(projectID int, alarm HoneybadgerAlarm) (HoneybadgerAlarm, error) {
+	var hbAlarm HoneybadgerAlarm
+
+	jsonPayload, err := json.Marshal(map[string]HoneybadgerAlarm{"alarm": alarm})
+	if err != nil {
+		return HoneybadgerAlarm{}, err
+	}
+
+	url := fmt.Sprintf("%s/v2/projects/%d/alarms", hbc.HostURL, projectID)
+	req, err := http.NewRequest("POST", url, bytes.NewBuffer(jsonPayload))
+	if err != nil {
+		return HoneybadgerAlarm{}, err
+	}
+
+	body, err := hbc.DoRequest(req)
+	if err != nil {
+		return HoneybadgerAlarm{}, err
+	}
+
+	err = json.Unmarshal(body, &hbAlarm)
+	if err != nil {
+		return HoneybadgerAlarm{}, err
+	}
+
+	return hbAlarm, nil
+}
+
+// UpdateAlarm - Update an Insights alarm
+func (hbc *HoneybadgerClient) UpdateAlarm(projectID int, alarmID int, alarm HoneybadgerAlarm) error {
+	jsonPayload, err := json.Marshal(map[string]HoneybadgerAlarm{"alarm": alarm})
+	if err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("%s/v2/projects/%d/alarms/%d", hbc.HostURL, projectID, alarmID)
+	req, err := http.NewRequest("PUT", url, bytes.NewBuffer(jsonPayload))
+	if err != nil {
+		return err
+	}
+
+	_, err = hbc.DoRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteAlarm - Delete an Insights alarm
+func (hbc *HoneybadgerClient) DeleteAlarm(projectID int, alarmID int) error {
+	url := fmt.Sprintf("%s/v2/projects/%d/alarms/%d", hbc.HostURL, projectID, alarmID)
+	req, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = hbc.DoRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
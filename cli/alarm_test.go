@@ -0,0 +1,93 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+	"net/http"
+	"testing"
+)
+
+var honeybadgerAlarm = HoneybadgerAlarm{
+	Name:             "Checkout errors",
+	Query:            "filter status >= 500 | stats count()",
+	EvaluationPeriod: "5m",
+	LookbackLag:      "1m",
+	TriggerConfig: HoneybadgerAlarmTrigger{
+		Type:     "result_count",
+		Operator: "gt",
+		Value:    10,
+	},
+	IntegrationIDs: []int{7, 8},
+}
+
+func TestGetAlarm(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+	alarmID := 42
+	urlPath := fmt.Sprintf("/v2/projects/%d/alarms/%d", honeybadgerProjectID, alarmID)
+
+	expectedResponse := honeybadgerAlarm
+	expectedResponse.ID = alarmID
+	expectedBody, _ := json.Marshal(expectedResponse)
+	gock.New(honeybadgerAPIHost).
+		Get(urlPath).
+		Reply(http.StatusOK).
+		JSON(expectedBody)
+
+	actualResponse, actualErrResponse := honeybadgerCli.GetAlarm(honeybadgerProjectID, alarmID)
+
+	assert.Equal(expectedResponse, actualResponse, "Actual response is different from expected response")
+	assert.Equal(actualErrResponse, nil, "Reponse error must be nil")
+}
+
+func TestCreateAlarm(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+	urlPath := fmt.Sprintf("/v2/projects/%d/alarms", honeybadgerProjectID)
+
+	expectedResponse := honeybadgerAlarm
+	expectedResponse.ID = 42
+	expectedBody, _ := json.Marshal(expectedResponse)
+	gock.New(honeybadgerAPIHost).
+		Post(urlPath).
+		BodyString(`{"alarm":{"name":"Checkout errors","description":"","query":"filter status >= 500 | stats count()","evaluation_period":"5m","lookback_lag":"1m","trigger_config":{"type":"result_count","operator":"gt","value":10},"integration_ids":[7,8]}}`).
+		Reply(http.StatusCreated).
+		JSON(expectedBody)
+
+	actualResponse, actualErrResponse := honeybadgerCli.CreateAlarm(honeybadgerProjectID, honeybadgerAlarm)
+
+	assert.Equal(expectedResponse, actualResponse, "Actual response is different from expected response")
+	assert.Equal(actualErrResponse, nil, "Reponse error must be nil")
+}
+
+func TestUpdateAlarm(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+	alarmID := 42
+	urlPath := fmt.Sprintf("/v2/projects/%d/alarms/%d", honeybadgerProjectID, alarmID)
+
+	gock.New(honeybadgerAPIHost).
+		Put(urlPath).
+		Reply(http.StatusNoContent)
+
+	errResponse := honeybadgerCli.UpdateAlarm(honeybadgerProjectID, alarmID, honeybadgerAlarm)
+
+	assert.Equal(errResponse, nil, "Reponse error must be nil")
+}
+
+func TestDeleteAlarm(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+	alarmID := 42
+	urlPath := fmt.Sprintf("/v2/projects/%d/alarms/%d", honeybadgerProjectID, alarmID)
+
+	gock.New(honeybadgerAPIHost).
+		Delete(urlPath).
+		Reply(http.StatusNoContent)
+
+	errResponse := honeybadgerCli.DeleteAlarm(honeybadgerProjectID, alarmID)
+
+	assert.Equal(errResponse, nil, "Reponse error must be nil")
+}
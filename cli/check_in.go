@@ -0,0 +1,26 @@
+package cli
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// CheckInPingURL - URL reporting a check-in, as shown in the check-in settings
+func (hbc *HoneybadgerClient) CheckInPingURL(checkInID string) string {
+	return fmt.Sprintf("%s/v1/check_in/%s", hbc.ReportingURL, checkInID)
+}
+
+// PingCheckIn - Report a check-in. Ping URLs carry their own token, so the request is not authenticated
+func (hbc *HoneybadgerClient) PingCheckIn(pingURL string) error {
+	req, err := http.NewRequest("GET", pingURL, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = hbc.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
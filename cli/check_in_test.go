@@ -0,0 +1,37 @@
+package cli
+
+import (
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+	"net/http"
+	"testing"
+)
+
+func TestPingCheckIn(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+
+	gock.New(honeybadgerAPIHost).
+		Get("/v1/check_in/abc123").
+		Reply(http.StatusOK).
+		BodyString("OK")
+
+	errResponse := honeybadgerCli.PingCheckIn(honeybadgerCli.CheckInPingURL("abc123"))
+
+	assert.Equal(errResponse, nil, "Reponse error must be nil")
+}
+
+func TestPingCheckInNotFound(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+
+	gock.New(honeybadgerAPIHost).
+		Get("/v1/check_in/unknown").
+		Reply(http.StatusNotFound).
+		BodyString("Not found")
+
+	errResponse := honeybadgerCli.PingCheckIn(honeybadgerCli.CheckInPingURL("unknown"))
+
+	assert.EqualError(errResponse, `status: 404, body: Not found`, "Reponse error must be 404")
+	assert.ErrorIs(errResponse, ErrNotFound, "Reponse error must match ErrNotFound")
+}
@@ -1,38 +1,116 @@
 package cli
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"net/url"
+	"strings"
 	"time"
 )
 
 const HoneybadgerURL string = "https://app.honeybadger.io"
 
+// DefaultTimeout - Bounds every request whose context has no deadline of its own
+const DefaultTimeout = 30 * time.Second
+
+// HoneybadgerReportingURL - Host of the reporting API (deploys, check-ins, source maps)
+const HoneybadgerReportingURL string = "https://api.honeybadger.io"
+
+// HoneybadgerEUURL - Host of the data API of the EU region
+const HoneybadgerEUURL string = "https://eu-app.honeybadger.io"
+
+// HoneybadgerEUReportingURL - Host of the reporting API of the EU region
+const HoneybadgerEUReportingURL string = "https://eu-api.honeybadger.io"
+
+// ErrNotFound - The API answered that the requested object does not exist
+var ErrNotFound = errors.New("not found")
+
+// notFoundError - Keeps the message of the other failed requests, while matching ErrNotFound
+type notFoundError struct {
+	body []byte
+}
+
+func (e *notFoundError) Error() string {
+	return fmt.Sprintf("status: %d, body: %s", http.StatusNotFound, e.body)
+}
+
+func (e *notFoundError) Is(target error) bool {
+	return target == ErrNotFound
+}
+
 type HoneybadgerClient struct {
-	HostURL    string
-	HTTPClient *http.Client
-	ApiToken   string
+	HostURL      string
+	ReportingURL string
+	HTTPClient   *http.Client
+	ApiToken     string
+	// AccountID - When set, projects and teams are listed from and created in this account only
+	AccountID string
+	// ctx - Cancels the requests of the client, see WithContext
+	ctx context.Context
 }
 
 func NewClient(host *string, apiToken *string) *HoneybadgerClient {
 	hbc := &HoneybadgerClient{
-		HTTPClient: &http.Client{Timeout: 30 * time.Second},
-		HostURL:    HoneybadgerURL,
-		ApiToken:   *apiToken,
+		HTTPClient:   &http.Client{},
+		HostURL:      HoneybadgerURL,
+		ReportingURL: HoneybadgerReportingURL,
+		ApiToken:     *apiToken,
 	}
 
+	// A custom host (e.g. a mock server) serves both APIs
 	if *host != "" {
 		hbc.HostURL = *host
+		hbc.ReportingURL = *host
 	}
 	return hbc
 }
 
+// WithContext - Copy of the client whose requests are cancelled with ctx, e.g. when the timeout of a
+// resource operation expires
+func (hbc *HoneybadgerClient) WithContext(ctx context.Context) *HoneybadgerClient {
+	c := *hbc
+	c.ctx = ctx
+	return &c
+}
+
+// RegionURLs - Data API and reporting API hosts of a Honeybadger region, us or eu
+func RegionURLs(region string) (string, string, error) {
+	switch region {
+	case "", "us":
+		return HoneybadgerURL, HoneybadgerReportingURL, nil
+	case "eu":
+		return HoneybadgerEUURL, HoneybadgerEUReportingURL, nil
+	}
+	return "", "", fmt.Errorf("unknown region %q, expected us or eu", region)
+}
+
+// DoRequest - Sends a request to the data API, authenticated with the personal auth token
 func (hbc *HoneybadgerClient) DoRequest(req *http.Request) ([]byte, error) {
 	req.SetBasicAuth(hbc.ApiToken, "")
 	req.Header.Set("Content-Type", "application/json")
 
-	res, err := hbc.HTTPClient.Do(req)
+	return hbc.doRequest(req)
+}
+
+// DoReportingRequest - Sends a request to the reporting API, authenticated with the project API key
+func (hbc *HoneybadgerClient) DoReportingRequest(req *http.Request, projectAPIKey string) ([]byte, error) {
+	req.Header.Set("X-API-Key", projectAPIKey)
+	req.Header.Set("Accept", "application/json")
+	if req.Header.Get("Content-Type") == "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	return hbc.doRequest(req)
+}
+
+func (hbc *HoneybadgerClient) doRequest(req *http.Request) ([]byte, error) {
+	ctx, cancel := hbc.requestContext()
+	defer cancel()
+
+	res, err := hbc.HTTPClient.Do(req.WithContext(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -43,6 +121,9 @@ func (hbc *HoneybadgerClient) DoRequest(req *http.Request) ([]byte, error) {
 		return nil, err
 	}
 
+	if res.StatusCode == http.StatusNotFound {
+		return nil, &notFoundError{body: body}
+	}
 	if (res.StatusCode != http.StatusOK) &&
 		(res.StatusCode != http.StatusCreated) &&
 		(res.StatusCode != http.StatusAccepted) &&
@@ -52,3 +133,33 @@ func (hbc *HoneybadgerClient) DoRequest(req *http.Request) ([]byte, error) {
 
 	return body, err
 }
+
+// requestContext - Context of a request, bounded by DefaultTimeout when the one of the client has no deadline
+func (hbc *HoneybadgerClient) requestContext() (context.Context, context.CancelFunc) {
+	ctx := hbc.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if _, ok := ctx.Deadline(); ok {
+		return ctx, func() {}
+	}
+
+	return context.WithTimeout(ctx, DefaultTimeout)
+}
+
+// pageURL - Builds the URL of a page returned in the links of a paginated response
+func (hbc *HoneybadgerClient) pageURL(link string) string {
+	if strings.HasPrefix(link, "http://") || strings.HasPrefix(link, "https://") {
+		return link
+	}
+	return fmt.Sprintf("%s/%s", hbc.HostURL, strings.TrimPrefix(link, "/"))
+}
+
+// accountScopedURL - Builds the URL of an account wide collection, restricted to the configured account
+func (hbc *HoneybadgerClient) accountScopedURL(collection string) string {
+	collectionURL := fmt.Sprintf("%s/v2/%s", hbc.HostURL, collection)
+	if hbc.AccountID != "" {
+		collectionURL = collectionURL + "?account_id=" + url.QueryEscape(hbc.AccountID)
+	}
+	return collectionURL
+}
@@ -0,0 +1,109 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRegionURLs(t *testing.T) {
+	assert := assert.New(t)
+
+	expectedURLs := []struct {
+		region       string
+		hostURL      string
+		reportingURL string
+	}{
+		{region: "", hostURL: HoneybadgerURL, reportingURL: HoneybadgerReportingURL},
+		{region: "us", hostURL: HoneybadgerURL, reportingURL: HoneybadgerReportingURL},
+		{region: "eu", hostURL: HoneybadgerEUURL, reportingURL: HoneybadgerEUReportingURL},
+	}
+
+	for _, expectedURL := range expectedURLs {
+		hostURL, reportingURL, err := RegionURLs(expectedURL.region)
+
+		assert.Equal(expectedURL.hostURL, hostURL, "Wrong data API host")
+		assert.Equal(expectedURL.reportingURL, reportingURL, "Wrong reporting API host")
+		assert.Equal(err, nil, "Reponse error must be nil")
+	}
+
+	_, _, err := RegionURLs("ap")
+	assert.NotEqual(err, nil, "Unknown regions must be rejected")
+}
+
+func TestWithContext(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+	urlPath := "/v2/projects"
+
+	gock.New(honeybadgerAPIHost).
+		Get(urlPath).
+		Reply(http.StatusOK).
+		Delay(time.Second).
+		JSON(`{"results":[]}`)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	projectCli := NewClient(&honeybadgerAPIHost, &honeybadgerAPIKey)
+	_, err := projectCli.WithContext(ctx).GetProjects()
+
+	assert.ErrorIs(err, context.DeadlineExceeded, "Requests must be cancelled with the context")
+	assert.Nil(projectCli.ctx, "The original client must keep its context")
+}
+
+func TestLimitAbovePageSize(t *testing.T) {
+	assert := assert.New(t)
+
+	// Limits above the page size are not sent, the pages are followed up to the limit instead
+	listRequests := []struct {
+		name    string
+		urlPath string
+		list    func() error
+	}{
+		{
+			name:    "deploys",
+			urlPath: fmt.Sprintf("/v2/projects/%d/deploys", honeybadgerProjectID),
+			list: func() error {
+				_, err := honeybadgerCli.GetDeploys(honeybadgerProjectID, HoneybadgerDeployQuery{Limit: 100})
+				return err
+			},
+		},
+		{
+			name:    "site outages",
+			urlPath: fmt.Sprintf("/v2/projects/%d/sites/%s/outages", honeybadgerProjectID, honeybadgerSiteID),
+			list: func() error {
+				_, err := honeybadgerCli.GetSiteOutages(honeybadgerProjectID, honeybadgerSiteID, HoneybadgerSiteQuery{Limit: 100})
+				return err
+			},
+		},
+		{
+			name:    "notices",
+			urlPath: fmt.Sprintf("/v2/projects/%d/faults/%d/notices", honeybadgerProjectID, honeybadgerFaultID),
+			list: func() error {
+				_, err := honeybadgerCli.GetNotices(honeybadgerProjectID, honeybadgerFaultID, HoneybadgerNoticeQuery{Limit: 100})
+				return err
+			},
+		},
+	}
+
+	for _, listRequest := range listRequests {
+		gock.New(honeybadgerAPIHost).
+			Get(listRequest.urlPath).
+			AddMatcher(func(req *http.Request, _ *gock.Request) (bool, error) {
+				return !req.URL.Query().Has("limit"), nil
+			}).
+			Reply(http.StatusOK).
+			BodyString(`{"results":[],"links":{}}`)
+
+		err := listRequest.list()
+
+		assert.Equal(err, nil, "Reponse error must be nil for the %s", listRequest.name)
+		assert.True(gock.IsDone(), "The limit must not be sent for the %s", listRequest.name)
+		gock.Off()
+	}
+}
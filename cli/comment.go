@@ -0,0 +1,133 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// GetComments - Get the comments of a fault, following pagination
+func (hbc *HoneybadgerClient) GetComments(projectID int, faultID int) ([]HoneybadgerComment, error) {
+	var hbCommentList []HoneybadgerComment
+
+	pageURL := fmt.Sprintf("%s/v2/projects/%d/faults/%d/comments", hbc.HostURL, projectID, faultID)
+	for pageURL != "" {
+		var hbComments HoneybadgerComments
+
+		req, err := http.NewRequest("GET", pageURL, nil)
+		if err != nil {
+			return hbCommentList, err
+		}
+
+		body, err := hbc.DoRequest(req)
+		if err != nil {
+			return hbCommentList, err
+		}
+
+		err = json.Unmarshal(body, &hbComments)
+		if err != nil {
+			return hbCommentList, err
+		}
+
+		hbCommentList = append(hbCommentList, hbComments.Comments...)
+
+		pageURL = ""
+		if hbComments.Links.NextPage != "" {
+			pageURL = hbc.pageURL(hbComments.Links.NextPage)
+		}
+	}
+
+	return hbCommentList, nil
+}
+
+// ErrCommentNotFound - The fault has no comment with the given ID
+var ErrCommentNotFound = errors.New("Comment not found")
+
+// FindCommentByID - Find a comment of a fault by ID
+func (hbc *HoneybadgerClient) FindCommentByID(projectID int, faultID int, commentID int) (HoneybadgerComment, error) {
+	hbComments, err := hbc.GetComments(projectID, faultID)
+	if err != nil {
+		return HoneybadgerComment{}, err
+	}
+
+	for _, comment := range hbComments {
+		if comment.ID == commentID {
+			return comment, nil
+		}
+	}
+	return HoneybadgerComment{}, ErrCommentNotFound
+}
+
+// CreateComment - Create a comment on a fault
+func (hbc *HoneybadgerClient) CreateComment(projectID int, faultID int, commentBody string) (HoneybadgerComment, error) {
+	var hbComment HoneybadgerComment
+
+	jsonPayload, err := commentPayload(commentBody)
+	if err != nil {
+		return HoneybadgerComment{}, err
+	}
+
+	url := fmt.Sprintf("%s/v2/projects/%d/faults/%d/comments", hbc.HostURL, projectID, faultID)
+	req, err := http.NewRequest("POST", url, bytes.NewBuffer(jsonPayload))
+	if err != nil {
+		return HoneybadgerComment{}, err
+	}
+
+	body, err := hbc.DoRequest(req)
+	if err != nil {
+		return HoneybadgerComment{}, err
+	}
+
+	err = json.Unmarshal(body, &hbComment)
+	if err != nil {
+		return HoneybadgerComment{}, err
+	}
+
+	return hbComment, nil
+}
+
+// UpdateComment - Update the body of a comment
+func (hbc *HoneybadgerClient) UpdateComment(projectID int, faultID int, commentID int, commentBody string) error {
+	jsonPayload, err := commentPayload(commentBody)
+	if err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("%s/v2/projects/%d/faults/%d/comments/%d", hbc.HostURL, projectID, faultID, commentID)
+	req, err := http.NewRequest("PUT", url, bytes.NewBuffer(jsonPayload))
+	if err != nil {
+		return err
+	}
+
+	_, err = hbc.DoRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteComment - Delete a comment
+func (hbc *HoneybadgerClient) DeleteComment(projectID int, faultID int, commentID int) error {
+	url := fmt.Sprintf("%s/v2/projects/%d/faults/%d/comments/%d", hbc.HostURL, projectID, faultID, commentID)
+	req, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = hbc.DoRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// commentPayload - Comment bodies are free text (markdown, quotes, new lines), so they must be JSON encoded
+func commentPayload(commentBody string) ([]byte, error) {
+	return json.Marshal(map[string]map[string]string{
+		"comment": {"body": commentBody},
+	})
+}
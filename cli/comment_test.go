@@ -0,0 +1,117 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+	"net/http"
+	"testing"
+)
+
+var honeybadgerFaultID = 999
+
+func TestGetCommentsNotProperlyAnswering(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+	urlPath := fmt.Sprintf("/v2/projects/%d/faults/%d/comments", honeybadgerProjectID, honeybadgerFaultID)
+
+	expectedResponse := HoneybadgerComments{}
+	expectedErrorResponse := errors.New(`status: 500, body: {"results":null,"links":{"self":"","prev":"","next":""}}`)
+	expectedBody, _ := json.Marshal(expectedResponse)
+	gock.New(honeybadgerAPIHost).
+		Get(urlPath).
+		Reply(http.StatusInternalServerError).
+		JSON(expectedBody)
+
+	actualResponse, actualErrResponse := honeybadgerCli.GetComments(honeybadgerProjectID, honeybadgerFaultID)
+
+	assert.Equal(expectedResponse.Comments, actualResponse, "Actual response is different from expected response")
+	assert.Equal(actualErrResponse, expectedErrorResponse, "Reponse error must be 500")
+}
+
+func TestFindCommentByID(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+	urlPath := fmt.Sprintf("/v2/projects/%d/faults/%d/comments", honeybadgerProjectID, honeybadgerFaultID)
+
+	expectedResponse := HoneybadgerComments{
+		Comments: []HoneybadgerComment{
+			{ID: 1, FaultID: honeybadgerFaultID, Author: "Test Sequra", Body: "Runbook: https://wiki.sequra.es/runbooks/timeouts"},
+			{ID: 2, FaultID: honeybadgerFaultID, Body: "Fixed in b6826b8"},
+		},
+	}
+	expectedBody, _ := json.Marshal(expectedResponse)
+
+	expectedResponses := []struct {
+		commentID int
+		response  HoneybadgerComment
+		err       error
+	}{
+		{commentID: 2, response: expectedResponse.Comments[1]},
+		{commentID: 3, err: ErrCommentNotFound},
+	}
+
+	for _, expected := range expectedResponses {
+		gock.New(honeybadgerAPIHost).
+			Get(urlPath).
+			Reply(http.StatusOK).
+			JSON(expectedBody)
+
+		actualResponse, actualErrResponse := honeybadgerCli.FindCommentByID(honeybadgerProjectID, honeybadgerFaultID, expected.commentID)
+
+		assert.Equal(expected.response, actualResponse, "Actual response is different from expected response")
+		assert.Equal(expected.err, actualErrResponse, "Reponse error does not match")
+	}
+}
+
+func TestCreateComment(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+	urlPath := fmt.Sprintf("/v2/projects/%d/faults/%d/comments", honeybadgerProjectID, honeybadgerFaultID)
+
+	expectedResponse := HoneybadgerComment{ID: 1, FaultID: honeybadgerFaultID, Body: "See \"runbook\"\nhttps://wiki.sequra.es"}
+	expectedBody, _ := json.Marshal(expectedResponse)
+	gock.New(honeybadgerAPIHost).
+		Post(urlPath).
+		BodyString(`{"comment":{"body":"See \"runbook\"\nhttps://wiki.sequra.es"}}`).
+		Reply(http.StatusCreated).
+		JSON(expectedBody)
+
+	actualResponse, actualErrResponse := honeybadgerCli.CreateComment(honeybadgerProjectID, honeybadgerFaultID, "See \"runbook\"\nhttps://wiki.sequra.es")
+
+	assert.Equal(expectedResponse, actualResponse, "Actual response is different from expected response")
+	assert.Equal(actualErrResponse, nil, "Reponse error must be nil")
+}
+
+func TestUpdateComment(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+	commentID := 1
+	urlPath := fmt.Sprintf("/v2/projects/%d/faults/%d/comments/%d", honeybadgerProjectID, honeybadgerFaultID, commentID)
+
+	gock.New(honeybadgerAPIHost).
+		Put(urlPath).
+		BodyString(`{"comment":{"body":"Updated runbook"}}`).
+		Reply(http.StatusNoContent)
+
+	errResponse := honeybadgerCli.UpdateComment(honeybadgerProjectID, honeybadgerFaultID, commentID, "Updated runbook")
+
+	assert.Equal(errResponse, nil, "Reponse error must be nil")
+}
+
+func TestDeleteComment(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+	commentID := 1
+	urlPath := fmt.Sprintf("/v2/projects/%d/faults/%d/comments/%d", honeybadgerProjectID, honeybadgerFaultID, commentID)
+
+	gock.New(honeybadgerAPIHost).
+		Delete(urlPath).
+		Reply(http.StatusNoContent)
+
+	errResponse := honeybadgerCli.DeleteComment(honeybadgerProjectID, honeybadgerFaultID, commentID)
+
+	assert.Equal(errResponse, nil, "Reponse error must be nil")
+}
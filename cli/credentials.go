@@ -0,0 +1,71 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+)
+
+// CredentialsError - A provider setting that does not allow to use the API
+type CredentialsError struct {
+	// Setting - Name of the provider setting that is wrong: host, api_key or account_id
+	Setting string
+	Reason  string
+}
+
+func (e *CredentialsError) Error() string {
+	return fmt.Sprintf("invalid %s: %s", e.Setting, e.Reason)
+}
+
+// ValidateCredentials - Lists the accounts of the API key, the cheapest authenticated call, and
+// checks that the configured account is one of them
+func (hbc *HoneybadgerClient) ValidateCredentials() error {
+	var hbAccounts HoneybadgerAccounts
+
+	url := fmt.Sprintf("%s/v2/accounts", hbc.HostURL)
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return &CredentialsError{Setting: "host", Reason: err.Error()}
+	}
+	req.SetBasicAuth(hbc.ApiToken, "")
+	req.Header.Set("Content-Type", "application/json")
+
+	// The status is needed to tell which setting is wrong, so the request does not go through
+	// doRequest, but it is bounded by the same timeout
+	ctx, cancel := hbc.requestContext()
+	defer cancel()
+
+	res, err := hbc.HTTPClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return &CredentialsError{Setting: "host", Reason: fmt.Sprintf("%s cannot be reached: %s", hbc.HostURL, err)}
+	}
+	defer res.Body.Close()
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return &CredentialsError{Setting: "host", Reason: fmt.Sprintf("%s cannot be reached: %s", hbc.HostURL, err)}
+	}
+
+	switch {
+	case res.StatusCode == http.StatusUnauthorized || res.StatusCode == http.StatusForbidden:
+		return &CredentialsError{Setting: "api_key", Reason: fmt.Sprintf("the API key is not accepted by %s (status: %d)", hbc.HostURL, res.StatusCode)}
+	case res.StatusCode != http.StatusOK:
+		return &CredentialsError{Setting: "host", Reason: fmt.Sprintf("%s does not serve the Honeybadger API (status: %d)", hbc.HostURL, res.StatusCode)}
+	}
+
+	err = json.Unmarshal(body, &hbAccounts)
+	if err != nil {
+		return &CredentialsError{Setting: "host", Reason: fmt.Sprintf("%s does not serve the Honeybadger API: %s", hbc.HostURL, err)}
+	}
+
+	if hbc.AccountID == "" {
+		return nil
+	}
+	for _, account := range hbAccounts.Accounts {
+		if account.ID == hbc.AccountID {
+			return nil
+		}
+	}
+	return &CredentialsError{Setting: "account_id", Reason: fmt.Sprintf("account %s is not accessible with the API key", hbc.AccountID)}
+}
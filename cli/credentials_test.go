@@ -0,0 +1,110 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestValidateCredentials(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+	urlPath := "/v2/accounts"
+
+	accountCli := NewClient(&honeybadgerAPIHost, &honeybadgerAPIKey)
+	accountCli.AccountID = honeybadgerAccountID
+
+	expectedBody, _ := json.Marshal(HoneybadgerAccounts{
+		Accounts: []HoneybadgerAccount{{ID: honeybadgerAccountID, Name: "Sequra"}},
+	})
+	gock.New(honeybadgerAPIHost).
+		Get(urlPath).
+		Reply(http.StatusOK).
+		JSON(expectedBody)
+
+	errResponse := accountCli.ValidateCredentials()
+
+	assert.Equal(errResponse, nil, "Reponse error must be nil")
+}
+
+func TestValidateCredentialsWrongSetting(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+	urlPath := "/v2/accounts"
+
+	accountsBody, _ := json.Marshal(HoneybadgerAccounts{
+		Accounts: []HoneybadgerAccount{{ID: "other", Name: "Other"}},
+	})
+
+	expectedSettings := []struct {
+		status  int
+		body    string
+		setting string
+	}{
+		{status: http.StatusUnauthorized, body: `{"errors":"Unauthorized"}`, setting: "api_key"},
+		{status: http.StatusNotFound, body: `Not found`, setting: "host"},
+		{status: http.StatusOK, body: `<html></html>`, setting: "host"},
+		{status: http.StatusOK, body: string(accountsBody), setting: "account_id"},
+	}
+
+	accountCli := NewClient(&honeybadgerAPIHost, &honeybadgerAPIKey)
+	accountCli.AccountID = honeybadgerAccountID
+
+	for _, expectedSetting := range expectedSettings {
+		gock.New(honeybadgerAPIHost).
+			Get(urlPath).
+			Reply(expectedSetting.status).
+			BodyString(expectedSetting.body)
+
+		errResponse := accountCli.ValidateCredentials()
+
+		credentialsErr, ok := errResponse.(*CredentialsError)
+		assert.True(ok, "Reponse error must be a CredentialsError")
+		if ok {
+			assert.Equal(expectedSetting.setting, credentialsErr.Setting, "Wrong setting reported")
+		}
+	}
+}
+
+func TestValidateCredentialsUnreachableHost(t *testing.T) {
+	assert := assert.New(t)
+
+	unreachableHost := "http://127.0.0.1:1"
+	unreachableCli := NewClient(&unreachableHost, &honeybadgerAPIKey)
+
+	errResponse := unreachableCli.ValidateCredentials()
+
+	credentialsErr, ok := errResponse.(*CredentialsError)
+	assert.True(ok, "Reponse error must be a CredentialsError")
+	if ok {
+		assert.Equal("host", credentialsErr.Setting, "Wrong setting reported")
+	}
+}
+
+func TestValidateCredentialsWithContext(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+	urlPath := "/v2/accounts"
+
+	gock.New(honeybadgerAPIHost).
+		Get(urlPath).
+		Reply(http.StatusOK).
+		Delay(time.Second).
+		JSON(`{"results":[]}`)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	accountCli := NewClient(&honeybadgerAPIHost, &honeybadgerAPIKey)
+	errResponse := accountCli.WithContext(ctx).ValidateCredentials()
+
+	credentialsErr, ok := errResponse.(*CredentialsError)
+	assert.True(ok, "Reponse error must be a CredentialsError")
+	if ok {
+		assert.Equal("host", credentialsErr.Setting, "Wrong setting reported")
+	}
+}
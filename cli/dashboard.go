@@ -0,0 +1,96 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// GetDashboard - Get an Insights dashboard of a project
+func (hbc *HoneybadgerClient) GetDashboard(projectID int, dashboardID int) (HoneybadgerDashboard, error) {
+	var hbDashboard HoneybadgerDashboard
+
+	url := fmt.Sprintf("%s/v2/projects/%d/dashboards/%d", hbc.HostURL, projectID, dashboardID)
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return HoneybadgerDashboard{}, err
+	}
+
+	body, err := hbc.DoRequest(req)
+	if err != nil {
+		return HoneybadgerDashboard{}, err
+	}
+
+	err = json.Unmarshal(body, &hbDashboard)
+	if err != nil {
+		return HoneybadgerDashboard{}, err
+	}
+
+	return hbDashboard, nil
+}
+
+// CreateDashboard - Create an Insights dashboard in a project
+func (hbc *HoneybadgerClient) CreateDashboard(projectID int, dashboard HoneybadgerDashboard) (HoneybadgerDashboard, error) {
+	var hbDashboard HoneybadgerDashboard
+
+	jsonPayload, err := json.Marshal(map[string]HoneybadgerDashboard{"dashboard": dashboard})
+	if err != nil {
+		return HoneybadgerDashboard{}, err
+	}
+
+	url := fmt.Sprintf("%s/v2/projects/%d/dashboards", hbc.HostURL, projectID)
+	req, err := http.NewRequest("POST", url, bytes.NewBuffer(jsonPayload))
+	if err != nil {
+		return HoneybadgerDashboard{}, err
+	}
+
+	body, err := hbc.DoRequest(req)
+	if err != nil {
+		return HoneybadgerDashboard{}, err
+	}
+
+	err = json.Unmarshal(body, &hbDashboard)
+	if err != nil {
+		return HoneybadgerDashboard{}, err
+	}
+
+	return hbDashboard, nil
+}
+
+// UpdateDashboard - Replace the title and the widgets of an Insights dashboard
+func (hbc *HoneybadgerClient) UpdateDashboard(projectID int, dashboardID int, dashboard HoneybadgerDashboard) error {
+	jsonPayload, err := json.Marshal(map[string]HoneybadgerDashboard{"dashboard": dashboard})
+	if err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("%s/v2/projects/%d/dashboards/%d", hbc.HostURL, projectID, dashboardID)
+	req, err := http.NewRequest("PUT", url, bytes.NewBuffer(jsonPayload))
+	if err != nil {
+		return err
+	}
+
+	_, err = hbc.DoRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteDashboard - Delete an Insights dashboard
+func (hbc *HoneybadgerClient) DeleteDashboard(projectID int, dashboardID int) error {
+	url := fmt.Sprintf("%s/v2/projects/%d/dashboards/%d", hbc.HostURL, projectID, dashboardID)
+	req, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = hbc.DoRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
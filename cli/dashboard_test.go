@@ -0,0 +1,96 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+	"net/http"
+	"testing"
+)
+
+var honeybadgerDashboard = HoneybadgerDashboard{
+	Title: "Checkout service",
+	Widgets: []HoneybadgerWidget{
+		{
+			Type:  "insights_vis",
+			Title: "Errors",
+			Config: HoneybadgerWidgetConfig{
+				Query: "stats count() by bin(1h)",
+				Ts:    "PT3H",
+				Vis:   HoneybadgerWidgetVis{View: "line"},
+			},
+			Grid: HoneybadgerWidgetGrid{X: 0, Y: 0, W: 6, H: 4},
+		},
+	},
+}
+
+func TestGetDashboard(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+	dashboardID := 42
+	urlPath := fmt.Sprintf("/v2/projects/%d/dashboards/%d", honeybadgerProjectID, dashboardID)
+
+	expectedResponse := honeybadgerDashboard
+	expectedResponse.ID = dashboardID
+	expectedBody, _ := json.Marshal(expectedResponse)
+	gock.New(honeybadgerAPIHost).
+		Get(urlPath).
+		Reply(http.StatusOK).
+		JSON(expectedBody)
+
+	actualResponse, actualErrResponse := honeybadgerCli.GetDashboard(honeybadgerProjectID, dashboardID)
+
+	assert.Equal(expectedResponse, actualResponse, "Actual response is different from expected response")
+	assert.Equal(actualErrResponse, nil, "Reponse error must be nil")
+}
+
+func TestCreateDashboard(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+	urlPath := fmt.Sprintf("/v2/projects/%d/dashboards", honeybadgerProjectID)
+
+	expectedResponse := honeybadgerDashboard
+	expectedResponse.ID = 42
+	expectedBody, _ := json.Marshal(expectedResponse)
+	gock.New(honeybadgerAPIHost).
+		Post(urlPath).
+		BodyString(`{"dashboard":{"title":"Checkout service","widgets":[{"type":"insights_vis","title":"Errors","config":{"query":"stats count() by bin(1h)","ts":"PT3H","vis":{"view":"line"}},"grid":{"x":0,"y":0,"w":6,"h":4}}]}}`).
+		Reply(http.StatusCreated).
+		JSON(expectedBody)
+
+	actualResponse, actualErrResponse := honeybadgerCli.CreateDashboard(honeybadgerProjectID, honeybadgerDashboard)
+
+	assert.Equal(expectedResponse, actualResponse, "Actual response is different from expected response")
+	assert.Equal(actualErrResponse, nil, "Reponse error must be nil")
+}
+
+func TestUpdateDashboard(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+	dashboardID := 42
+	urlPath := fmt.Sprintf("/v2/projects/%d/dashboards/%d", honeybadgerProjectID, dashboardID)
+
+	gock.New(honeybadgerAPIHost).
+		Put(urlPath).
+		Reply(http.StatusNoContent)
+
+	errResponse := honeybadgerCli.UpdateDashboard(honeybadgerProjectID, dashboardID, honeybadgerDashboard)
+
+	assert.Equal(errResponse, nil, "Reponse error must be nil")
+}
+
+func TestDeleteDashboard(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+	dashboardID := 42
+	urlPath := fmt.Sprintf("/v2/projects/%d/dashboards/%d", honeybadgerProjectID, dashboardID)
+
+	gock.New(honeybadgerAPIHost).
+		Delete(urlPath).
+		Reply(http.StatusNoContent)
+
+	errResponse := honeybadgerCli.DeleteDashboard(honeybadgerProjectID, dashboardID)
+
+	assert.Equal(errResponse, nil, "Reponse error must be nil")
+}
@@ -0,0 +1,109 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// CreateDeploy - Notify Honeybadger of a deploy of the project owning the API key
+func (hbc *HoneybadgerClient) CreateDeploy(projectAPIKey string, deploy HoneybadgerDeploy) error {
+	jsonPayload, err := json.Marshal(deployPayload{Deploy: deployPayloadFields{
+		Environment:   deploy.Environment,
+		Revision:      deploy.Revision,
+		Repository:    deploy.Repository,
+		LocalUsername: deploy.LocalUsername,
+	}})
+	if err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("%s/v1/deploys", hbc.ReportingURL)
+	req, err := http.NewRequest("POST", url, bytes.NewBuffer(jsonPayload))
+	if err != nil {
+		return err
+	}
+
+	_, err = hbc.DoReportingRequest(req, projectAPIKey)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// deployPayload - Body of a deploy notification. The values are set by the user, so they must be JSON encoded
+type deployPayload struct {
+	Deploy deployPayloadFields `json:"deploy"`
+}
+
+type deployPayloadFields struct {
+	Environment   string `json:"environment"`
+	Revision      string `json:"revision"`
+	Repository    string `json:"repository"`
+	LocalUsername string `json:"local_username"`
+}
+
+// deploysPageSize - Maximum number of deploys the API returns per page
+const deploysPageSize = 25
+
+// GetDeploys - Get the deploys of a project matching the query, following pagination up to the limit
+func (hbc *HoneybadgerClient) GetDeploys(projectID int, query HoneybadgerDeployQuery) ([]HoneybadgerDeploy, error) {
+	var hbDeployList []HoneybadgerDeploy
+
+	params := url.Values{}
+	if query.Environment != "" {
+		params.Set("environment", query.Environment)
+	}
+	if query.LocalUsername != "" {
+		params.Set("local_username", query.LocalUsername)
+	}
+	if query.CreatedAfter != "" {
+		params.Set("created_after", query.CreatedAfter)
+	}
+	if query.CreatedBefore != "" {
+		params.Set("created_before", query.CreatedBefore)
+	}
+	if query.Limit > 0 && query.Limit < deploysPageSize {
+		params.Set("limit", strconv.Itoa(query.Limit))
+	}
+
+	pageURL := fmt.Sprintf("%s/v2/projects/%d/deploys", hbc.HostURL, projectID)
+	if len(params) > 0 {
+		pageURL = pageURL + "?" + params.Encode()
+	}
+
+	for pageURL != "" {
+		var hbDeploys HoneybadgerDeploys
+
+		req, err := http.NewRequest("GET", pageURL, nil)
+		if err != nil {
+			return hbDeployList, err
+		}
+
+		body, err := hbc.DoRequest(req)
+		if err != nil {
+			return hbDeployList, err
+		}
+
+		err = json.Unmarshal(body, &hbDeploys)
+		if err != nil {
+			return hbDeployList, err
+		}
+
+		hbDeployList = append(hbDeployList, hbDeploys.Deploys...)
+		if query.Limit > 0 && len(hbDeployList) >= query.Limit {
+			return hbDeployList[:query.Limit], nil
+		}
+
+		pageURL = ""
+		if hbDeploys.Links.NextPage != "" {
+			pageURL = hbc.pageURL(hbDeploys.Links.NextPage)
+		}
+	}
+
+	return hbDeployList, nil
+}
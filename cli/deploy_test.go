@@ -0,0 +1,155 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+	"net/http"
+	"testing"
+)
+
+var honeybadgerProjectAPIKey = "hbp_123"
+
+func TestCreateDeploy(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+	urlPath := "/v1/deploys"
+
+	expectedBody, _ := json.Marshal(map[string]string{"status": "OK"})
+	gock.New(honeybadgerAPIHost).
+		Post(urlPath).
+		MatchHeader("X-API-Key", honeybadgerProjectAPIKey).
+		BodyString(`{"deploy":{"environment":"production", "revision":"b6826b8", "repository":"git@github.com:sequra/app.git", "local_username":"terraform"}}`).
+		Reply(http.StatusCreated).
+		JSON(expectedBody)
+
+	errResponse := honeybadgerCli.CreateDeploy(honeybadgerProjectAPIKey, HoneybadgerDeploy{
+		Environment:   "production",
+		Revision:      "b6826b8",
+		Repository:    "git@github.com:sequra/app.git",
+		LocalUsername: "terraform",
+	})
+
+	assert.Equal(errResponse, nil, "Reponse error must be nil")
+}
+
+func TestCreateDeployWithQuotes(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+	urlPath := "/v1/deploys"
+
+	expectedBody, _ := json.Marshal(map[string]string{"status": "OK"})
+	gock.New(honeybadgerAPIHost).
+		Post(urlPath).
+		MatchHeader("X-API-Key", honeybadgerProjectAPIKey).
+		AddMatcher(func(req *http.Request, _ *gock.Request) (bool, error) {
+			var payload map[string]map[string]string
+			if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+				return false, nil
+			}
+			return payload["deploy"]["local_username"] == `jane "the deployer"`, nil
+		}).
+		Reply(http.StatusCreated).
+		JSON(expectedBody)
+
+	errResponse := honeybadgerCli.CreateDeploy(honeybadgerProjectAPIKey, HoneybadgerDeploy{
+		Environment:   "production",
+		Revision:      "b6826b8",
+		LocalUsername: `jane "the deployer"`,
+	})
+
+	assert.Equal(errResponse, nil, "Reponse error must be nil")
+	assert.True(gock.IsDone(), "The payload must be JSON encoded")
+}
+
+func TestCreateDeployWithInvalidAPIKey(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+	urlPath := "/v1/deploys"
+
+	expectedErrorResponse := errors.New(`status: 403, body: {"error":"Invalid API key"}`)
+	gock.New(honeybadgerAPIHost).
+		Post(urlPath).
+		Reply(http.StatusForbidden).
+		BodyString(`{"error":"Invalid API key"}`)
+
+	errResponse := honeybadgerCli.CreateDeploy("invalid", HoneybadgerDeploy{Environment: "production", Revision: "b6826b8"})
+
+	assert.Equal(expectedErrorResponse, errResponse, "Reponse error must be 403")
+}
+
+func TestGetDeploys(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+
+	expectedPaginatedResponse := []struct {
+		hbDeploys HoneybadgerDeploys
+		urlPath   string
+	}{
+		{
+			urlPath: fmt.Sprintf("/v2/projects/%d/deploys", honeybadgerProjectID),
+			hbDeploys: HoneybadgerDeploys{
+				Deploys: []HoneybadgerDeploy{
+					{ID: 2, Environment: "production", Revision: "b6826b8", CreatedAt: "2022-08-02T10:00:00Z"},
+				},
+				Links: HoneybadgerLink{
+					NextPage: "/deploys_page2",
+				},
+			},
+		},
+		{
+			urlPath: "/deploys_page2",
+			hbDeploys: HoneybadgerDeploys{
+				Deploys: []HoneybadgerDeploy{
+					{ID: 1, Environment: "production", Revision: "a1b2c3d", CreatedAt: "2022-08-01T10:00:00Z"},
+				},
+			},
+		},
+	}
+
+	for _, expectedResponse := range expectedPaginatedResponse {
+		expectedBodyPage, _ := json.Marshal(expectedResponse.hbDeploys)
+		gock.New(honeybadgerAPIHost).
+			Get(expectedResponse.urlPath).
+			Reply(http.StatusOK).
+			JSON(expectedBodyPage)
+	}
+
+	actualResponse, actualErrResponse := honeybadgerCli.GetDeploys(honeybadgerProjectID, HoneybadgerDeployQuery{Environment: "production"})
+
+	assert.Equal(append(expectedPaginatedResponse[0].hbDeploys.Deploys, expectedPaginatedResponse[1].hbDeploys.Deploys...), actualResponse, "Actual response is different from expected response")
+	assert.Equal(actualErrResponse, nil, "Reponse error must be nil")
+}
+
+func TestGetDeploysWithFilters(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+	urlPath := fmt.Sprintf("/v2/projects/%d/deploys", honeybadgerProjectID)
+
+	expectedResponse := HoneybadgerDeploys{
+		Deploys: []HoneybadgerDeploy{
+			{ID: 2, Environment: "staging", Revision: "b6826b8", LocalUsername: "terraform"},
+		},
+	}
+	expectedBody, _ := json.Marshal(expectedResponse)
+	gock.New(honeybadgerAPIHost).
+		Get(urlPath).
+		MatchParam("environment", "staging").
+		MatchParam("local_username", "terraform").
+		MatchParam("created_after", "1660000000").
+		MatchParam("limit", "1").
+		Reply(http.StatusOK).
+		JSON(expectedBody)
+
+	actualResponse, actualErrResponse := honeybadgerCli.GetDeploys(honeybadgerProjectID, HoneybadgerDeployQuery{
+		Environment:   "staging",
+		LocalUsername: "terraform",
+		CreatedAfter:  "1660000000",
+		Limit:         1,
+	})
+
+	assert.Equal(expectedResponse.Deploys, actualResponse, "Actual response is different from expected response")
+	assert.Equal(actualErrResponse, nil, "Reponse error must be nil")
+}
@@ -0,0 +1,139 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// faultsPageSize - Maximum number of faults the API returns per page
+const faultsPageSize = 25
+
+// GetFaults - Get the faults of a project matching the query, following pagination up to the limit
+func (hbc *HoneybadgerClient) GetFaults(projectID int, query HoneybadgerFaultQuery) ([]HoneybadgerFault, error) {
+	var hbFaultList []HoneybadgerFault
+
+	params := url.Values{}
+	if query.Query != "" {
+		params.Set("q", query.Query)
+	}
+	if query.CreatedAfter != "" {
+		params.Set("created_after", query.CreatedAfter)
+	}
+	if query.OccurredAfter != "" {
+		params.Set("occurred_after", query.OccurredAfter)
+	}
+	if query.OccurredBefore != "" {
+		params.Set("occurred_before", query.OccurredBefore)
+	}
+	if query.Order != "" {
+		params.Set("order", query.Order)
+	}
+	if query.Limit > 0 && query.Limit < faultsPageSize {
+		params.Set("limit", strconv.Itoa(query.Limit))
+	}
+
+	pageURL := fmt.Sprintf("%s/v2/projects/%d/faults", hbc.HostURL, projectID)
+	if len(params) > 0 {
+		pageURL = pageURL + "?" + params.Encode()
+	}
+
+	for pageURL != "" {
+		var hbFaults HoneybadgerFaults
+
+		req, err := http.NewRequest("GET", pageURL, nil)
+		if err != nil {
+			return hbFaultList, err
+		}
+
+		body, err := hbc.DoRequest(req)
+		if err != nil {
+			return hbFaultList, err
+		}
+
+		err = json.Unmarshal(body, &hbFaults)
+		if err != nil {
+			return hbFaultList, err
+		}
+
+		hbFaultList = append(hbFaultList, hbFaults.Faults...)
+		if query.Limit > 0 && len(hbFaultList) >= query.Limit {
+			return hbFaultList[:query.Limit], nil
+		}
+
+		pageURL = ""
+		if hbFaults.Links.NextPage != "" {
+			pageURL = hbc.pageURL(hbFaults.Links.NextPage)
+		}
+	}
+
+	return hbFaultList, nil
+}
+
+// ErrFaultNotFound - The project has no fault with the given ID, e.g. once it is deleted
+var ErrFaultNotFound = errors.New("fault not found")
+
+// GetFault - Get a single fault of a project
+func (hbc *HoneybadgerClient) GetFault(projectID int, faultID int) (HoneybadgerFault, error) {
+	var hbFault HoneybadgerFault
+
+	url := fmt.Sprintf("%s/v2/projects/%d/faults/%d", hbc.HostURL, projectID, faultID)
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return HoneybadgerFault{}, err
+	}
+
+	body, err := hbc.DoRequest(req)
+	if errors.Is(err, ErrNotFound) {
+		return HoneybadgerFault{}, fmt.Errorf("Fault %d not found in project %d: %w", faultID, projectID, ErrFaultNotFound)
+	}
+	if err != nil {
+		return HoneybadgerFault{}, err
+	}
+
+	err = json.Unmarshal(body, &hbFault)
+	if err != nil {
+		return HoneybadgerFault{}, err
+	}
+
+	return hbFault, nil
+}
+
+// UpdateFault - Update the resolved, ignored and assignee state of a fault, only for the fields that are set
+func (hbc *HoneybadgerClient) UpdateFault(projectID int, faultID int, update HoneybadgerFaultUpdate) error {
+	fields := map[string]interface{}{}
+	if update.IsResolved != nil {
+		fields["resolved"] = *update.IsResolved
+	}
+	if update.IsIgnored != nil {
+		fields["ignored"] = *update.IsIgnored
+	}
+	if update.AssigneeID != nil {
+		fields["assignee_id"] = nil
+		if *update.AssigneeID > 0 {
+			fields["assignee_id"] = *update.AssigneeID
+		}
+	}
+
+	jsonPayload, err := json.Marshal(map[string]interface{}{"fault": fields})
+	if err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("%s/v2/projects/%d/faults/%d", hbc.HostURL, projectID, faultID)
+	req, err := http.NewRequest("PUT", url, bytes.NewBuffer(jsonPayload))
+	if err != nil {
+		return err
+	}
+
+	_, err = hbc.DoRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,191 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+	"net/http"
+	"testing"
+)
+
+var honeybadgerProjectID = 1234
+
+func TestGetFaultsNotProperlyAnswering(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+	urlPath := fmt.Sprintf("/v2/projects/%d/faults", honeybadgerProjectID)
+
+	expectedResponse := HoneybadgerFaults{}
+	expectedErrorResponse := errors.New(`status: 500, body: {"results":null,"links":{"self":"","prev":"","next":""}}`)
+	expectedBody, _ := json.Marshal(expectedResponse)
+	gock.New(honeybadgerAPIHost).
+		Get(urlPath).
+		Reply(http.StatusInternalServerError).
+		JSON(expectedBody)
+
+	actualResponse, actualErrResponse := honeybadgerCli.GetFaults(honeybadgerProjectID, HoneybadgerFaultQuery{})
+
+	assert.Equal(expectedResponse.Faults, actualResponse, "Actual response is different from expected response")
+	assert.Equal(actualErrResponse, expectedErrorResponse, "Reponse error must be 500")
+}
+
+func TestGetFaultsWithFilters(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+	urlPath := fmt.Sprintf("/v2/projects/%d/faults", honeybadgerProjectID)
+
+	expectedResponse := HoneybadgerFaults{
+		Faults: []HoneybadgerFault{
+			{
+				ID:           1,
+				ProjectID:    honeybadgerProjectID,
+				Klass:        "RuntimeError",
+				Message:      "oops",
+				Environment:  "production",
+				NoticesCount: 7,
+				Assignee: &HoneybadgerAssignee{
+					ID:    945,
+					Email: "test.sequra@sequra.es",
+				},
+			},
+		},
+	}
+	expectedBody, _ := json.Marshal(expectedResponse)
+	gock.New(honeybadgerAPIHost).
+		Get(urlPath).
+		MatchParam("q", "-is:resolved environment:production").
+		MatchParam("occurred_after", "1660000000").
+		MatchParam("order", "frequent").
+		Reply(http.StatusOK).
+		JSON(expectedBody)
+
+	actualResponse, actualErrResponse := honeybadgerCli.GetFaults(honeybadgerProjectID, HoneybadgerFaultQuery{
+		Query:         "-is:resolved environment:production",
+		OccurredAfter: "1660000000",
+		Order:         "frequent",
+	})
+
+	assert.Equal(expectedResponse.Faults, actualResponse, "Actual response is different from expected response")
+	assert.Equal(actualErrResponse, nil, "Reponse error must be nil")
+}
+
+func TestGetFaultsWithPaginationAndLimit(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+
+	expectedPaginatedResponse := []struct {
+		hbFaults HoneybadgerFaults
+		urlPath  string
+	}{
+		{
+			urlPath: fmt.Sprintf("/v2/projects/%d/faults", honeybadgerProjectID),
+			hbFaults: HoneybadgerFaults{
+				Faults: []HoneybadgerFault{{ID: 1}, {ID: 2}},
+				Links: HoneybadgerLink{
+					NextPage: "http://localhost/faults_page2",
+				},
+			},
+		},
+		{
+			urlPath: "/faults_page2",
+			hbFaults: HoneybadgerFaults{
+				Faults: []HoneybadgerFault{{ID: 3}, {ID: 4}},
+				Links: HoneybadgerLink{
+					NextPage: "http://localhost/faults_page3",
+				},
+			},
+		},
+	}
+
+	for _, expectedResponse := range expectedPaginatedResponse {
+		expectedBodyPage, _ := json.Marshal(expectedResponse.hbFaults)
+		gock.New(honeybadgerAPIHost).
+			Get(expectedResponse.urlPath).
+			Reply(http.StatusOK).
+			JSON(expectedBodyPage)
+	}
+
+	actualResponse, actualErrResponse := honeybadgerCli.GetFaults(honeybadgerProjectID, HoneybadgerFaultQuery{Limit: 3})
+
+	assert.Equal([]HoneybadgerFault{{ID: 1}, {ID: 2}, {ID: 3}}, actualResponse, "Actual response is different from expected response")
+	assert.Equal(actualErrResponse, nil, "Reponse error must be nil")
+	assert.True(gock.IsDone(), "Third page must not be requested")
+}
+
+func TestGetFault(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+	faultID := 999
+	urlPath := fmt.Sprintf("/v2/projects/%d/faults/%d", honeybadgerProjectID, faultID)
+
+	expectedResponse := HoneybadgerFault{
+		ID:         faultID,
+		ProjectID:  honeybadgerProjectID,
+		Klass:      "RuntimeError",
+		IsResolved: true,
+	}
+	expectedBody, _ := json.Marshal(expectedResponse)
+	gock.New(honeybadgerAPIHost).
+		Get(urlPath).
+		Reply(http.StatusOK).
+		JSON(expectedBody)
+
+	actualResponse, actualErrResponse := honeybadgerCli.GetFault(honeybadgerProjectID, faultID)
+
+	assert.Equal(expectedResponse, actualResponse, "Actual response is different from expected response")
+	assert.Equal(actualErrResponse, nil, "Reponse error must be nil")
+}
+
+func TestGetFaultNotFound(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+	faultID := 999
+	urlPath := fmt.Sprintf("/v2/projects/%d/faults/%d", honeybadgerProjectID, faultID)
+
+	gock.New(honeybadgerAPIHost).
+		Get(urlPath).
+		Reply(http.StatusNotFound).
+		BodyString(`{"errors":"Not found"}`)
+
+	_, actualErrResponse := honeybadgerCli.GetFault(honeybadgerProjectID, faultID)
+
+	assert.ErrorIs(actualErrResponse, ErrFaultNotFound, "Reponse error must be ErrFaultNotFound")
+}
+
+func TestUpdateFault(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+	faultID := 999
+	urlPath := fmt.Sprintf("/v2/projects/%d/faults/%d", honeybadgerProjectID, faultID)
+
+	isIgnored := true
+	assigneeID := 945
+	unassignedID := 0
+
+	expectedPayloads := []struct {
+		update  HoneybadgerFaultUpdate
+		payload string
+	}{
+		{
+			update:  HoneybadgerFaultUpdate{IsIgnored: &isIgnored, AssigneeID: &assigneeID},
+			payload: `{"fault":{"ignored":true, "assignee_id":945}}`,
+		},
+		{
+			update:  HoneybadgerFaultUpdate{AssigneeID: &unassignedID},
+			payload: `{"fault":{"assignee_id":null}}`,
+		},
+	}
+
+	for _, expectedPayload := range expectedPayloads {
+		gock.New(honeybadgerAPIHost).
+			Put(urlPath).
+			BodyString(expectedPayload.payload).
+			Reply(http.StatusNoContent)
+
+		errResponse := honeybadgerCli.UpdateFault(honeybadgerProjectID, faultID, expectedPayload.update)
+
+		assert.Equal(errResponse, nil, "Reponse error must be nil")
+	}
+}
@@ -0,0 +1,37 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// QueryInsights - Run a BadgerQL query over the Insights events of a project
+func (hbc *HoneybadgerClient) QueryInsights(projectID int, query HoneybadgerInsightsQuery) (HoneybadgerInsightsResult, error) {
+	var hbResult HoneybadgerInsightsResult
+
+	// BadgerQL queries are multi line and contain quotes, so they must be JSON encoded
+	jsonPayload, err := json.Marshal(query)
+	if err != nil {
+		return HoneybadgerInsightsResult{}, err
+	}
+
+	url := fmt.Sprintf("%s/v2/projects/%d/insights/queries", hbc.HostURL, projectID)
+	req, err := http.NewRequest("POST", url, bytes.NewBuffer(jsonPayload))
+	if err != nil {
+		return HoneybadgerInsightsResult{}, err
+	}
+
+	body, err := hbc.DoRequest(req)
+	if err != nil {
+		return HoneybadgerInsightsResult{}, err
+	}
+
+	err = json.Unmarshal(body, &hbResult)
+	if err != nil {
+		return HoneybadgerInsightsResult{}, err
+	}
+
+	return hbResult, nil
+}
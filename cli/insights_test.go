@@ -0,0 +1,56 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+	"net/http"
+	"testing"
+)
+
+func TestQueryInsights(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+	urlPath := fmt.Sprintf("/v2/projects/%d/insights/queries", honeybadgerProjectID)
+
+	gock.New(honeybadgerAPIHost).
+		Post(urlPath).
+		BodyString(`{"query":"fields status\n| stats count() by status","ts":"PT3H"}`).
+		Reply(http.StatusOK).
+		BodyString(`{"results":[{"status":200,"count()":1520},{"status":500,"count()":3}],"meta":{"fields":["status","count()"],"rows":2,"total_rows":2}}`)
+
+	actualResponse, actualErrResponse := honeybadgerCli.QueryInsights(honeybadgerProjectID, HoneybadgerInsightsQuery{
+		Query: "fields status\n| stats count() by status",
+		Ts:    "PT3H",
+	})
+
+	expectedResponse := HoneybadgerInsightsResult{
+		Results: []map[string]interface{}{
+			{"status": float64(200), "count()": float64(1520)},
+			{"status": float64(500), "count()": float64(3)},
+		},
+		Meta: HoneybadgerInsightsMeta{
+			Fields:    []string{"status", "count()"},
+			Rows:      2,
+			TotalRows: 2,
+		},
+	}
+	assert.Equal(expectedResponse, actualResponse, "Actual response is different from expected response")
+	assert.Equal(actualErrResponse, nil, "Reponse error must be nil")
+}
+
+func TestQueryInsightsWithInvalidQuery(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+	urlPath := fmt.Sprintf("/v2/projects/%d/insights/queries", honeybadgerProjectID)
+
+	gock.New(honeybadgerAPIHost).
+		Post(urlPath).
+		Reply(http.StatusUnprocessableEntity).
+		BodyString(`{"errors":"Unknown function: cout"}`)
+
+	_, actualErrResponse := honeybadgerCli.QueryInsights(honeybadgerProjectID, HoneybadgerInsightsQuery{Query: "stats cout()"})
+
+	assert.Equal(errors.New(`status: 422, body: {"errors":"Unknown function: cout"}`), actualErrResponse, "Reponse error must be 422")
+}
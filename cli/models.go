@@ -1,5 +1,10 @@
 package cli
 
+import (
+	"encoding/json"
+	"fmt"
+)
+
 type HoneybadgerUsers struct {
 	Users []HoneybadgerUser `json:"results"`
 	Links HoneybadgerLink   `json:"links"`
@@ -12,6 +17,7 @@ type HoneybadgerUser struct {
 	CreatedAt string `json:"created_at"`
 	TeamID    int    `json:"team_id"`
 	IsAdmin   bool   `json:"admin"`
+	IsPending bool   `json:"-"`
 }
 
 type HoneybadgerLink struct {
@@ -57,12 +63,341 @@ type HoneybadgerInvitation struct {
 	Token      string `json:"token"`
 	Email      string `json:"email"`
 	IsAdmin    bool   `json:"admin"`
+	Role       string `json:"role"`
 	AcceptedAt string `json:"accepted_at"`
 	CreatedAt  string `json:"created_at"`
 }
 
 type HoneybadgerTeamOwner struct {
-	ID    int    `json:"id`
+	ID    int    `json:"id"`
 	Email string `json:"email"`
 	Name  string `json:"name"`
 }
+
+type HoneybadgerAccounts struct {
+	Accounts []HoneybadgerAccount `json:"results"`
+	Links    HoneybadgerLink      `json:"links"`
+}
+
+type HoneybadgerAccount struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Email    string `json:"email"`
+	IsActive bool   `json:"active"`
+	ParentID string `json:"parent_id"`
+}
+
+type HoneybadgerAccountUsers struct {
+	Users []HoneybadgerAccountUser `json:"results"`
+	Links HoneybadgerLink          `json:"links"`
+}
+
+type HoneybadgerAccountUser struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	Email     string `json:"email"`
+	Role      string `json:"role"`
+	CreatedAt string `json:"created_at"`
+	IsPending bool   `json:"-"`
+}
+
+type HoneybadgerAccountInvitations struct {
+	Invitations []HoneybadgerInvitation `json:"results"`
+	Links       HoneybadgerLink         `json:"links"`
+}
+
+type HoneybadgerFaults struct {
+	Faults []HoneybadgerFault `json:"results"`
+	Links  HoneybadgerLink    `json:"links"`
+}
+
+type HoneybadgerFault struct {
+	ID            int                  `json:"id"`
+	ProjectID     int                  `json:"project_id"`
+	Klass         string               `json:"klass"`
+	Message       string               `json:"message"`
+	Component     string               `json:"component"`
+	Action        string               `json:"action"`
+	Environment   string               `json:"environment"`
+	NoticesCount  int                  `json:"notices_count"`
+	CommentsCount int                  `json:"comments_count"`
+	IsResolved    bool                 `json:"resolved"`
+	IsIgnored     bool                 `json:"ignored"`
+	Assignee      *HoneybadgerAssignee `json:"assignee"`
+	Tags          []string             `json:"tags"`
+	URL           string               `json:"url"`
+	CreatedAt     string               `json:"created_at"`
+	LastNoticeAt  string               `json:"last_notice_at"`
+}
+
+type HoneybadgerAssignee struct {
+	ID    int    `json:"id"`
+	Email string `json:"email"`
+	Name  string `json:"name"`
+}
+
+// HoneybadgerFaultUpdate - Fields of a fault to update, the nil ones are left as they are
+type HoneybadgerFaultUpdate struct {
+	IsResolved *bool
+	IsIgnored  *bool
+	// AssigneeID - An ID of 0 unassigns the fault
+	AssigneeID *int
+}
+
+type HoneybadgerFaultQuery struct {
+	Query          string
+	CreatedAfter   string
+	OccurredAfter  string
+	OccurredBefore string
+	Order          string
+	Limit          int
+}
+
+type HoneybadgerDeploys struct {
+	Deploys []HoneybadgerDeploy `json:"results"`
+	Links   HoneybadgerLink     `json:"links"`
+}
+
+type HoneybadgerDeploy struct {
+	ID            int    `json:"id"`
+	ProjectID     int    `json:"project_id"`
+	Environment   string `json:"environment"`
+	Revision      string `json:"revision"`
+	Repository    string `json:"repository"`
+	LocalUsername string `json:"local_username"`
+	URL           string `json:"url"`
+	CreatedAt     string `json:"created_at"`
+}
+
+type HoneybadgerDeployQuery struct {
+	Environment   string
+	LocalUsername string
+	CreatedAfter  string
+	CreatedBefore string
+	Limit         int
+}
+
+type HoneybadgerOccurrence struct {
+	Timestamp int64
+	Count     int
+}
+
+// UnmarshalJSON - Occurrences are returned as [timestamp, count] pairs
+func (o *HoneybadgerOccurrence) UnmarshalJSON(data []byte) error {
+	var pair []int64
+	if err := json.Unmarshal(data, &pair); err != nil {
+		return err
+	}
+	if len(pair) != 2 {
+		return fmt.Errorf("unexpected occurrence %s", data)
+	}
+	o.Timestamp = pair[0]
+	o.Count = int(pair[1])
+	return nil
+}
+
+type HoneybadgerReportRow struct {
+	Key   string
+	Count int
+}
+
+// UnmarshalJSON - Report rows are returned as [key, count] pairs
+func (r *HoneybadgerReportRow) UnmarshalJSON(data []byte) error {
+	var pair []json.RawMessage
+	if err := json.Unmarshal(data, &pair); err != nil {
+		return err
+	}
+	if len(pair) != 2 {
+		return fmt.Errorf("unexpected report row %s", data)
+	}
+	if err := json.Unmarshal(pair[0], &r.Key); err != nil {
+		return err
+	}
+	return json.Unmarshal(pair[1], &r.Count)
+}
+
+type HoneybadgerReportQuery struct {
+	StartAt     string
+	StopAt      string
+	Environment string
+}
+
+type HoneybadgerSiteOutages struct {
+	Outages []HoneybadgerSiteOutage `json:"results"`
+	Links   HoneybadgerLink         `json:"links"`
+}
+
+type HoneybadgerSiteOutage struct {
+	DownAt    string `json:"down_at"`
+	UpAt      string `json:"up_at"`
+	CreatedAt string `json:"created_at"`
+	Status    int    `json:"status"`
+	Reason    string `json:"reason"`
+}
+
+type HoneybadgerSiteChecks struct {
+	Checks []HoneybadgerSiteCheck `json:"results"`
+	Links  HoneybadgerLink        `json:"links"`
+}
+
+type HoneybadgerSiteCheck struct {
+	CreatedAt string `json:"created_at"`
+	Location  string `json:"location"`
+	Duration  int    `json:"duration"`
+	Response  int    `json:"response"`
+	IsUp      bool   `json:"up"`
+}
+
+type HoneybadgerSiteQuery struct {
+	CreatedAfter  string
+	CreatedBefore string
+	Limit         int
+}
+
+type HoneybadgerComments struct {
+	Comments []HoneybadgerComment `json:"results"`
+	Links    HoneybadgerLink      `json:"links"`
+}
+
+type HoneybadgerComment struct {
+	ID           int    `json:"id"`
+	FaultID      int    `json:"fault_id"`
+	Event        string `json:"event"`
+	Source       string `json:"source"`
+	NoticesCount int    `json:"notices_count"`
+	Author       string `json:"author"`
+	Body         string `json:"body"`
+	CreatedAt    string `json:"created_at"`
+}
+
+type HoneybadgerNotices struct {
+	Notices []HoneybadgerNotice `json:"results"`
+	Links   HoneybadgerLink     `json:"links"`
+}
+
+type HoneybadgerNotice struct {
+	ID              string                   `json:"id"`
+	FaultID         int                      `json:"fault_id"`
+	Message         string                   `json:"message"`
+	EnvironmentName string                   `json:"environment_name"`
+	URL             string                   `json:"url"`
+	CreatedAt       string                   `json:"created_at"`
+	Request         HoneybadgerNoticeRequest `json:"request"`
+	Backtrace       []HoneybadgerFrame       `json:"backtrace"`
+}
+
+type HoneybadgerNoticeRequest struct {
+	URL       string          `json:"url"`
+	Component string          `json:"component"`
+	Action    string          `json:"action"`
+	Context   json.RawMessage `json:"context"`
+	Params    json.RawMessage `json:"params"`
+}
+
+type HoneybadgerFrame struct {
+	Number string `json:"number"`
+	File   string `json:"file"`
+	Method string `json:"method"`
+}
+
+type HoneybadgerAffectedUser struct {
+	User  string `json:"user"`
+	Count int    `json:"count"`
+}
+
+type HoneybadgerNoticeQuery struct {
+	CreatedAfter  string
+	CreatedBefore string
+	Limit         int
+}
+
+type HoneybadgerInsightsQuery struct {
+	Query    string `json:"query"`
+	Ts       string `json:"ts,omitempty"`
+	Timezone string `json:"timezone,omitempty"`
+}
+
+type HoneybadgerInsightsResult struct {
+	Results []map[string]interface{} `json:"results"`
+	Meta    HoneybadgerInsightsMeta  `json:"meta"`
+}
+
+type HoneybadgerInsightsMeta struct {
+	Query     string   `json:"query"`
+	Fields    []string `json:"fields"`
+	Rows      int      `json:"rows"`
+	TotalRows int      `json:"total_rows"`
+	StartAt   string   `json:"start_at"`
+	EndAt     string   `json:"end_at"`
+}
+
+type HoneybadgerDashboards struct {
+	Dashboards []HoneybadgerDashboard `json:"results"`
+	Links      HoneybadgerLink        `json:"links"`
+}
+
+type HoneybadgerDashboard struct {
+	ID        int                 `json:"id,omitempty"`
+	Title     string              `json:"title"`
+	Widgets   []HoneybadgerWidget `json:"widgets"`
+	CreatedAt string              `json:"created_at,omitempty"`
+}
+
+type HoneybadgerWidget struct {
+	ID     string                  `json:"id,omitempty"`
+	Type   string                  `json:"type"`
+	Title  string                  `json:"title,omitempty"`
+	Config HoneybadgerWidgetConfig `json:"config"`
+	Grid   HoneybadgerWidgetGrid   `json:"grid"`
+}
+
+type HoneybadgerWidgetConfig struct {
+	Query string               `json:"query"`
+	Ts    string               `json:"ts,omitempty"`
+	Vis   HoneybadgerWidgetVis `json:"vis"`
+}
+
+type HoneybadgerWidgetVis struct {
+	View string `json:"view"`
+}
+
+type HoneybadgerWidgetGrid struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+	W int `json:"w"`
+	H int `json:"h"`
+}
+
+type HoneybadgerAlarm struct {
+	ID               int                     `json:"id,omitempty"`
+	Name             string                  `json:"name"`
+	Description      string                  `json:"description"`
+	Query            string                  `json:"query"`
+	EvaluationPeriod string                  `json:"evaluation_period"`
+	LookbackLag      string                  `json:"lookback_lag"`
+	TriggerConfig    HoneybadgerAlarmTrigger `json:"trigger_config"`
+	IntegrationIDs   []int                   `json:"integration_ids"`
+	State            string                  `json:"state,omitempty"`
+	CreatedAt        string                  `json:"created_at,omitempty"`
+}
+
+type HoneybadgerAlarmTrigger struct {
+	Type     string  `json:"type"`
+	Operator string  `json:"operator"`
+	Value    float64 `json:"value"`
+}
+
+type HoneybadgerStatusPage struct {
+	ID       int                         `json:"id,omitempty"`
+	Name     string                      `json:"name"`
+	Domain   string                      `json:"domain"`
+	Public   bool                        `json:"public"`
+	Sites    []HoneybadgerStatusPageItem `json:"sites"`
+	CheckIns []HoneybadgerStatusPageItem `json:"check_ins"`
+	URL      string                      `json:"url,omitempty"`
+}
+
+type HoneybadgerStatusPageItem struct {
+	ID          string `json:"id"`
+	DisplayName string `json:"display_name"`
+}
@@ -0,0 +1,94 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// noticesPageSize - Maximum number of notices or affected users the API returns per page
+const noticesPageSize = 25
+
+// GetNotices - Get the notices of a fault, following pagination up to the limit
+func (hbc *HoneybadgerClient) GetNotices(projectID int, faultID int, query HoneybadgerNoticeQuery) ([]HoneybadgerNotice, error) {
+	var hbNoticeList []HoneybadgerNotice
+
+	pageURL := fmt.Sprintf("%s/v2/projects/%d/faults/%d/notices", hbc.HostURL, projectID, faultID) + noticeQueryString(query)
+	for pageURL != "" {
+		var hbNotices HoneybadgerNotices
+
+		req, err := http.NewRequest("GET", pageURL, nil)
+		if err != nil {
+			return hbNoticeList, err
+		}
+
+		body, err := hbc.DoRequest(req)
+		if err != nil {
+			return hbNoticeList, err
+		}
+
+		err = json.Unmarshal(body, &hbNotices)
+		if err != nil {
+			return hbNoticeList, err
+		}
+
+		hbNoticeList = append(hbNoticeList, hbNotices.Notices...)
+		if query.Limit > 0 && len(hbNoticeList) >= query.Limit {
+			return hbNoticeList[:query.Limit], nil
+		}
+
+		pageURL = ""
+		if hbNotices.Links.NextPage != "" {
+			pageURL = hbc.pageURL(hbNotices.Links.NextPage)
+		}
+	}
+
+	return hbNoticeList, nil
+}
+
+// GetAffectedUsers - Get the users affected by a fault and how many notices each one got
+func (hbc *HoneybadgerClient) GetAffectedUsers(projectID int, faultID int, query HoneybadgerNoticeQuery) ([]HoneybadgerAffectedUser, error) {
+	var hbAffectedUsers []HoneybadgerAffectedUser
+
+	reqURL := fmt.Sprintf("%s/v2/projects/%d/faults/%d/affected_users", hbc.HostURL, projectID, faultID) + noticeQueryString(query)
+	req, err := http.NewRequest("GET", reqURL, nil)
+	if err != nil {
+		return hbAffectedUsers, err
+	}
+
+	body, err := hbc.DoRequest(req)
+	if err != nil {
+		return hbAffectedUsers, err
+	}
+
+	err = json.Unmarshal(body, &hbAffectedUsers)
+	if err != nil {
+		return hbAffectedUsers, err
+	}
+
+	if query.Limit > 0 && len(hbAffectedUsers) > query.Limit {
+		return hbAffectedUsers[:query.Limit], nil
+	}
+
+	return hbAffectedUsers, nil
+}
+
+func noticeQueryString(query HoneybadgerNoticeQuery) string {
+	params := url.Values{}
+	if query.CreatedAfter != "" {
+		params.Set("created_after", query.CreatedAfter)
+	}
+	if query.CreatedBefore != "" {
+		params.Set("created_before", query.CreatedBefore)
+	}
+	if query.Limit > 0 && query.Limit < noticesPageSize {
+		params.Set("limit", strconv.Itoa(query.Limit))
+	}
+
+	if len(params) == 0 {
+		return ""
+	}
+	return "?" + params.Encode()
+}
@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+	"net/http"
+	"testing"
+)
+
+func TestGetNotices(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+	urlPath := fmt.Sprintf("/v2/projects/%d/faults/%d/notices", honeybadgerProjectID, honeybadgerFaultID)
+
+	gock.New(honeybadgerAPIHost).
+		Get(urlPath).
+		MatchParam("created_after", "1660000000").
+		Reply(http.StatusOK).
+		BodyString(`{"results":[{"id":"9d3c4a6a-4b9c-4f8e-8d0e-2b1c4a1f0e3d","fault_id":999,"message":"oops","environment_name":"production","request":{"url":"https://sequra.es/checkout","component":"CheckoutController","action":"create","context":{"user_id":7}},"backtrace":[{"number":"12","file":"[PROJECT_ROOT]/app/controllers/checkout_controller.rb","method":"create"}]}],"links":{}}`)
+
+	actualResponse, actualErrResponse := honeybadgerCli.GetNotices(honeybadgerProjectID, honeybadgerFaultID, HoneybadgerNoticeQuery{CreatedAfter: "1660000000"})
+
+	expectedResponse := []HoneybadgerNotice{
+		{
+			ID:              "9d3c4a6a-4b9c-4f8e-8d0e-2b1c4a1f0e3d",
+			FaultID:         honeybadgerFaultID,
+			Message:         "oops",
+			EnvironmentName: "production",
+			Request: HoneybadgerNoticeRequest{
+				URL:       "https://sequra.es/checkout",
+				Component: "CheckoutController",
+				Action:    "create",
+				Context:   json.RawMessage(`{"user_id":7}`),
+			},
+			Backtrace: []HoneybadgerFrame{
+				{Number: "12", File: "[PROJECT_ROOT]/app/controllers/checkout_controller.rb", Method: "create"},
+			},
+		},
+	}
+	assert.Equal(expectedResponse, actualResponse, "Actual response is different from expected response")
+	assert.Equal(actualErrResponse, nil, "Reponse error must be nil")
+}
+
+func TestGetAffectedUsers(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+	urlPath := fmt.Sprintf("/v2/projects/%d/faults/%d/affected_users", honeybadgerProjectID, honeybadgerFaultID)
+
+	gock.New(honeybadgerAPIHost).
+		Get(urlPath).
+		Reply(http.StatusOK).
+		BodyString(`[{"user":"test.sequra@sequra.es","count":5},{"user":"test.sequra.page2@sequra.es","count":2}]`)
+
+	actualResponse, actualErrResponse := honeybadgerCli.GetAffectedUsers(honeybadgerProjectID, honeybadgerFaultID, HoneybadgerNoticeQuery{Limit: 1})
+
+	expectedResponse := []HoneybadgerAffectedUser{
+		{User: "test.sequra@sequra.es", Count: 5},
+	}
+	assert.Equal(expectedResponse, actualResponse, "Actual response is different from expected response")
+	assert.Equal(actualErrResponse, nil, "Reponse error must be nil")
+}
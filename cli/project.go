@@ -12,7 +12,7 @@ import (
 func (hbc *HoneybadgerClient) GetProjects() ([]HoneybadgerProject, error) {
 	var hbProjects HoneybadgerProjects
 
-	url := fmt.Sprintf("%s/v2/projects", hbc.HostURL)
+	url := hbc.accountScopedURL("projects")
 	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
 		return hbProjects.Projects, err
@@ -31,6 +31,9 @@ func (hbc *HoneybadgerClient) GetProjects() ([]HoneybadgerProject, error) {
 	return hbProjects.Projects, nil
 }
 
+// ErrProjectNotFound - The project is not listed, e.g. once its deletion is complete
+var ErrProjectNotFound = errors.New("Project not found")
+
 // FindProjectByName - Find Project by name
 func (hbc *HoneybadgerClient) FindProjectByName(projectName string) (HoneybadgerProject, error) {
 	hbProjects, err := hbc.GetProjects()
@@ -43,7 +46,7 @@ func (hbc *HoneybadgerClient) FindProjectByName(projectName string) (Honeybadger
 			return project, nil
 		}
 	}
-	return HoneybadgerProject{}, errors.New("Project not found")
+	return HoneybadgerProject{}, ErrProjectNotFound
 }
 
 // FindProjectByID - Find Project by ID
@@ -58,7 +61,7 @@ func (hbc *HoneybadgerClient) FindProjectByID(projectID int) (HoneybadgerProject
 			return project, nil
 		}
 	}
-	return HoneybadgerProject{}, errors.New("Project not found")
+	return HoneybadgerProject{}, ErrProjectNotFound
 }
 
 // CreateProject - Create Project
@@ -70,7 +73,7 @@ func (hbc *HoneybadgerClient) CreateProject(projectName string, language string)
 		jsonPayload = []byte(`{"project":{"name":"` + projectName + `", "language": "` + language + `"}}`)
 	}
 
-	url := fmt.Sprintf("%s/v2/projects", hbc.HostURL)
+	url := hbc.accountScopedURL("projects")
 	req, err := http.NewRequest("POST", url, bytes.NewBuffer(jsonPayload))
 	if err != nil {
 		return HoneybadgerProject{}, err
@@ -210,3 +210,48 @@ func TestDeleteProject(t *testing.T) {
 
 	assert.Equal(errResponse, nil, "Reponse error must be nil")
 }
+
+func TestGetProjectsScopedToAccount(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+	urlPath := "/v2/projects"
+
+	accountCli := NewClient(&honeybadgerAPIHost, &honeybadgerAPIKey)
+	accountCli.AccountID = honeybadgerAccountID
+
+	expectedResponse := HoneybadgerProjects{
+		Projects: []HoneybadgerProject{{ID: 1234, Name: "Test Sequra Project"}},
+	}
+	expectedBody, _ := json.Marshal(expectedResponse)
+	gock.New(honeybadgerAPIHost).
+		Get(urlPath).
+		MatchParam("account_id", honeybadgerAccountID).
+		Reply(http.StatusOK).
+		JSON(expectedBody)
+
+	actualResponse, actualErrResponse := accountCli.GetProjects()
+
+	assert.Equal(expectedResponse.Projects, actualResponse, "Actual response is different from expected response")
+	assert.Equal(actualErrResponse, nil, "Reponse error must be nil")
+}
+
+func TestCreateProjectScopedToAccount(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+	urlPath := "/v2/projects"
+
+	accountCli := NewClient(&honeybadgerAPIHost, &honeybadgerAPIKey)
+	accountCli.AccountID = honeybadgerAccountID
+
+	expectedBody, _ := json.Marshal(nil)
+	gock.New(honeybadgerAPIHost).
+		Post(urlPath).
+		MatchParam("account_id", honeybadgerAccountID).
+		Reply(http.StatusCreated).
+		JSON(expectedBody)
+
+	_, errResponse := accountCli.CreateProject("New Project", "ruby")
+
+	assert.Equal(errResponse, nil, "Reponse error must be nil")
+	assert.True(gock.IsDone(), "Project must be created in the configured account")
+}
@@ -0,0 +1,90 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// GetProjectOccurrences - Get the occurrence counts of a project grouped by environment
+func (hbc *HoneybadgerClient) GetProjectOccurrences(projectID int, period string, environment string) (map[string][]HoneybadgerOccurrence, error) {
+	hbOccurrences := map[string][]HoneybadgerOccurrence{}
+
+	params := url.Values{}
+	if period != "" {
+		params.Set("period", period)
+	}
+	if environment != "" {
+		params.Set("environment", environment)
+	}
+
+	reqURL := fmt.Sprintf("%s/v2/projects/%d/occurrences", hbc.HostURL, projectID)
+	if len(params) > 0 {
+		reqURL = reqURL + "?" + params.Encode()
+	}
+	req, err := http.NewRequest("GET", reqURL, nil)
+	if err != nil {
+		return hbOccurrences, err
+	}
+
+	body, err := hbc.DoRequest(req)
+	if err != nil {
+		return hbOccurrences, err
+	}
+
+	// A single series is returned when filtering by environment, otherwise one per environment
+	if environment != "" {
+		var hbSeries []HoneybadgerOccurrence
+		err = json.Unmarshal(body, &hbSeries)
+		if err != nil {
+			return hbOccurrences, err
+		}
+		hbOccurrences[environment] = hbSeries
+		return hbOccurrences, nil
+	}
+
+	err = json.Unmarshal(body, &hbOccurrences)
+	if err != nil {
+		return hbOccurrences, err
+	}
+
+	return hbOccurrences, nil
+}
+
+// GetNoticeReport - Get a notice report (notices_by_class, notices_by_location, notices_by_user or notices_per_day) of a project
+func (hbc *HoneybadgerClient) GetNoticeReport(projectID int, report string, query HoneybadgerReportQuery) ([]HoneybadgerReportRow, error) {
+	var hbReport []HoneybadgerReportRow
+
+	params := url.Values{}
+	if query.StartAt != "" {
+		params.Set("start_at", query.StartAt)
+	}
+	if query.StopAt != "" {
+		params.Set("stop_at", query.StopAt)
+	}
+	if query.Environment != "" {
+		params.Set("environment", query.Environment)
+	}
+
+	reqURL := fmt.Sprintf("%s/v2/projects/%d/reports/%s", hbc.HostURL, projectID, report)
+	if len(params) > 0 {
+		reqURL = reqURL + "?" + params.Encode()
+	}
+	req, err := http.NewRequest("GET", reqURL, nil)
+	if err != nil {
+		return hbReport, err
+	}
+
+	body, err := hbc.DoRequest(req)
+	if err != nil {
+		return hbReport, err
+	}
+
+	err = json.Unmarshal(body, &hbReport)
+	if err != nil {
+		return hbReport, err
+	}
+
+	return hbReport, nil
+}
@@ -0,0 +1,75 @@
+package cli
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+	"net/http"
+	"testing"
+)
+
+func TestGetProjectOccurrences(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+	urlPath := fmt.Sprintf("/v2/projects/%d/occurrences", honeybadgerProjectID)
+
+	gock.New(honeybadgerAPIHost).
+		Get(urlPath).
+		MatchParam("period", "day").
+		Reply(http.StatusOK).
+		BodyString(`{"production":[[1660003200,12],[1660089600,3]],"staging":[[1660003200,1]]}`)
+
+	actualResponse, actualErrResponse := honeybadgerCli.GetProjectOccurrences(honeybadgerProjectID, "day", "")
+
+	expectedResponse := map[string][]HoneybadgerOccurrence{
+		"production": {{Timestamp: 1660003200, Count: 12}, {Timestamp: 1660089600, Count: 3}},
+		"staging":    {{Timestamp: 1660003200, Count: 1}},
+	}
+	assert.Equal(expectedResponse, actualResponse, "Actual response is different from expected response")
+	assert.Equal(actualErrResponse, nil, "Reponse error must be nil")
+}
+
+func TestGetProjectOccurrencesByEnvironment(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+	urlPath := fmt.Sprintf("/v2/projects/%d/occurrences", honeybadgerProjectID)
+
+	gock.New(honeybadgerAPIHost).
+		Get(urlPath).
+		MatchParam("environment", "production").
+		Reply(http.StatusOK).
+		BodyString(`[[1660003200,12],[1660089600,3]]`)
+
+	actualResponse, actualErrResponse := honeybadgerCli.GetProjectOccurrences(honeybadgerProjectID, "", "production")
+
+	expectedResponse := map[string][]HoneybadgerOccurrence{
+		"production": {{Timestamp: 1660003200, Count: 12}, {Timestamp: 1660089600, Count: 3}},
+	}
+	assert.Equal(expectedResponse, actualResponse, "Actual response is different from expected response")
+	assert.Equal(actualErrResponse, nil, "Reponse error must be nil")
+}
+
+func TestGetNoticeReport(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+	urlPath := fmt.Sprintf("/v2/projects/%d/reports/notices_by_class", honeybadgerProjectID)
+
+	gock.New(honeybadgerAPIHost).
+		Get(urlPath).
+		MatchParam("environment", "production").
+		MatchParam("start_at", "2022-08-01T00:00:00Z").
+		Reply(http.StatusOK).
+		BodyString(`[["RuntimeError",8347],["SocketError",4651]]`)
+
+	actualResponse, actualErrResponse := honeybadgerCli.GetNoticeReport(honeybadgerProjectID, "notices_by_class", HoneybadgerReportQuery{
+		StartAt:     "2022-08-01T00:00:00Z",
+		Environment: "production",
+	})
+
+	expectedResponse := []HoneybadgerReportRow{
+		{Key: "RuntimeError", Count: 8347},
+		{Key: "SocketError", Count: 4651},
+	}
+	assert.Equal(expectedResponse, actualResponse, "Actual response is different from expected response")
+	assert.Equal(actualErrResponse, nil, "Reponse error must be nil")
+}
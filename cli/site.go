@@ -0,0 +1,104 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// sitesPageSize - Maximum number of outages or uptime checks the API returns per page
+const sitesPageSize = 25
+
+// GetSiteOutages - Get the outages of an uptime site, following pagination up to the limit
+func (hbc *HoneybadgerClient) GetSiteOutages(projectID int, siteID string, query HoneybadgerSiteQuery) ([]HoneybadgerSiteOutage, error) {
+	var hbOutageList []HoneybadgerSiteOutage
+
+	pageURL := fmt.Sprintf("%s/v2/projects/%d/sites/%s/outages", hbc.HostURL, projectID, siteID) + siteQueryString(query)
+	for pageURL != "" {
+		var hbOutages HoneybadgerSiteOutages
+
+		req, err := http.NewRequest("GET", pageURL, nil)
+		if err != nil {
+			return hbOutageList, err
+		}
+
+		body, err := hbc.DoRequest(req)
+		if err != nil {
+			return hbOutageList, err
+		}
+
+		err = json.Unmarshal(body, &hbOutages)
+		if err != nil {
+			return hbOutageList, err
+		}
+
+		hbOutageList = append(hbOutageList, hbOutages.Outages...)
+		if query.Limit > 0 && len(hbOutageList) >= query.Limit {
+			return hbOutageList[:query.Limit], nil
+		}
+
+		pageURL = ""
+		if hbOutages.Links.NextPage != "" {
+			pageURL = hbc.pageURL(hbOutages.Links.NextPage)
+		}
+	}
+
+	return hbOutageList, nil
+}
+
+// GetSiteChecks - Get the uptime checks of an uptime site, following pagination up to the limit
+func (hbc *HoneybadgerClient) GetSiteChecks(projectID int, siteID string, query HoneybadgerSiteQuery) ([]HoneybadgerSiteCheck, error) {
+	var hbCheckList []HoneybadgerSiteCheck
+
+	pageURL := fmt.Sprintf("%s/v2/projects/%d/sites/%s/uptime_checks", hbc.HostURL, projectID, siteID) + siteQueryString(query)
+	for pageURL != "" {
+		var hbChecks HoneybadgerSiteChecks
+
+		req, err := http.NewRequest("GET", pageURL, nil)
+		if err != nil {
+			return hbCheckList, err
+		}
+
+		body, err := hbc.DoRequest(req)
+		if err != nil {
+			return hbCheckList, err
+		}
+
+		err = json.Unmarshal(body, &hbChecks)
+		if err != nil {
+			return hbCheckList, err
+		}
+
+		hbCheckList = append(hbCheckList, hbChecks.Checks...)
+		if query.Limit > 0 && len(hbCheckList) >= query.Limit {
+			return hbCheckList[:query.Limit], nil
+		}
+
+		pageURL = ""
+		if hbChecks.Links.NextPage != "" {
+			pageURL = hbc.pageURL(hbChecks.Links.NextPage)
+		}
+	}
+
+	return hbCheckList, nil
+}
+
+func siteQueryString(query HoneybadgerSiteQuery) string {
+	params := url.Values{}
+	if query.CreatedAfter != "" {
+		params.Set("created_after", query.CreatedAfter)
+	}
+	if query.CreatedBefore != "" {
+		params.Set("created_before", query.CreatedBefore)
+	}
+	if query.Limit > 0 && query.Limit < sitesPageSize {
+		params.Set("limit", strconv.Itoa(query.Limit))
+	}
+
+	if len(params) == 0 {
+		return ""
+	}
+	return "?" + params.Encode()
+}
@@ -0,0 +1,112 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+	"net/http"
+	"testing"
+)
+
+var honeybadgerSiteID = "2d5b4e6e-4a3a-4e0d-9c8b-1f0d1e2c3b4a"
+
+func TestGetSiteOutagesNotProperlyAnswering(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+	urlPath := fmt.Sprintf("/v2/projects/%d/sites/%s/outages", honeybadgerProjectID, honeybadgerSiteID)
+
+	expectedResponse := HoneybadgerSiteOutages{}
+	expectedErrorResponse := errors.New(`status: 500, body: {"results":null,"links":{"self":"","prev":"","next":""}}`)
+	expectedBody, _ := json.Marshal(expectedResponse)
+	gock.New(honeybadgerAPIHost).
+		Get(urlPath).
+		Reply(http.StatusInternalServerError).
+		JSON(expectedBody)
+
+	actualResponse, actualErrResponse := honeybadgerCli.GetSiteOutages(honeybadgerProjectID, honeybadgerSiteID, HoneybadgerSiteQuery{})
+
+	assert.Equal(expectedResponse.Outages, actualResponse, "Actual response is different from expected response")
+	assert.Equal(actualErrResponse, expectedErrorResponse, "Reponse error must be 500")
+}
+
+func TestGetSiteOutages(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+	urlPath := fmt.Sprintf("/v2/projects/%d/sites/%s/outages", honeybadgerProjectID, honeybadgerSiteID)
+
+	expectedResponse := HoneybadgerSiteOutages{
+		Outages: []HoneybadgerSiteOutage{
+			{
+				DownAt: "2022-08-01T10:00:00Z",
+				UpAt:   "2022-08-01T10:05:00Z",
+				Status: 503,
+				Reason: "Expected 2xx status code. Got 503",
+			},
+		},
+	}
+	expectedBody, _ := json.Marshal(expectedResponse)
+	gock.New(honeybadgerAPIHost).
+		Get(urlPath).
+		MatchParam("created_after", "1660000000").
+		MatchParam("created_before", "1660100000").
+		Reply(http.StatusOK).
+		JSON(expectedBody)
+
+	actualResponse, actualErrResponse := honeybadgerCli.GetSiteOutages(honeybadgerProjectID, honeybadgerSiteID, HoneybadgerSiteQuery{
+		CreatedAfter:  "1660000000",
+		CreatedBefore: "1660100000",
+	})
+
+	assert.Equal(expectedResponse.Outages, actualResponse, "Actual response is different from expected response")
+	assert.Equal(actualErrResponse, nil, "Reponse error must be nil")
+}
+
+func TestGetSiteChecksWithPaginationAndLimit(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+
+	expectedPaginatedResponse := []struct {
+		hbChecks HoneybadgerSiteChecks
+		urlPath  string
+	}{
+		{
+			urlPath: fmt.Sprintf("/v2/projects/%d/sites/%s/uptime_checks", honeybadgerProjectID, honeybadgerSiteID),
+			hbChecks: HoneybadgerSiteChecks{
+				Checks: []HoneybadgerSiteCheck{
+					{Location: "Virginia", Duration: 120, Response: 200, IsUp: true},
+				},
+				Links: HoneybadgerLink{
+					NextPage: "/checks_page2",
+				},
+			},
+		},
+		{
+			urlPath: "/checks_page2",
+			hbChecks: HoneybadgerSiteChecks{
+				Checks: []HoneybadgerSiteCheck{
+					{Location: "Frankfurt", Duration: 30000, Response: 503, IsUp: false},
+					{Location: "London", Duration: 95, Response: 200, IsUp: true},
+				},
+			},
+		},
+	}
+
+	for _, expectedResponse := range expectedPaginatedResponse {
+		expectedBodyPage, _ := json.Marshal(expectedResponse.hbChecks)
+		gock.New(honeybadgerAPIHost).
+			Get(expectedResponse.urlPath).
+			Reply(http.StatusOK).
+			JSON(expectedBodyPage)
+	}
+
+	actualResponse, actualErrResponse := honeybadgerCli.GetSiteChecks(honeybadgerProjectID, honeybadgerSiteID, HoneybadgerSiteQuery{Limit: 2})
+
+	expectedResponse := []HoneybadgerSiteCheck{
+		{Location: "Virginia", Duration: 120, Response: 200, IsUp: true},
+		{Location: "Frankfurt", Duration: 30000, Response: 503, IsUp: false},
+	}
+	assert.Equal(expectedResponse, actualResponse, "Actual response is different from expected response")
+	assert.Equal(actualErrResponse, nil, "Reponse error must be nil")
+}
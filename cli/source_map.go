@@ -0,0 +1,75 @@
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"path/filepath"
+)
+
+// UploadSourceMap - Upload a source map and its minified file for a revision of a JavaScript project
+func (hbc *HoneybadgerClient) UploadSourceMap(projectAPIKey string, minifiedURL string, revision string, minifiedFile string, sourceMapFile string) error {
+	payload := &bytes.Buffer{}
+	writer := multipart.NewWriter(payload)
+
+	fields := map[string]string{
+		"api_key":      projectAPIKey,
+		"minified_url": minifiedURL,
+		"revision":     revision,
+	}
+	for name, value := range fields {
+		err := writer.WriteField(name, value)
+		if err != nil {
+			return err
+		}
+	}
+
+	files := map[string]string{
+		"minified_file": minifiedFile,
+		"source_map":    sourceMapFile,
+	}
+	for name, path := range files {
+		err := writeMultipartFile(writer, name, path)
+		if err != nil {
+			return err
+		}
+	}
+
+	err := writer.Close()
+	if err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("%s/v1/source_maps", hbc.ReportingURL)
+	req, err := http.NewRequest("POST", url, payload)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	_, err = hbc.DoReportingRequest(req, projectAPIKey)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func writeMultipartFile(writer *multipart.Writer, fieldName string, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	part, err := writer.CreateFormFile(fieldName, filepath.Base(path))
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(part, file)
+	return err
+}
@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestUploadSourceMap(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+	urlPath := "/v1/source_maps"
+
+	dir := t.TempDir()
+	minifiedFile := filepath.Join(dir, "app.min.js")
+	sourceMapFile := filepath.Join(dir, "app.min.js.map")
+	_ = os.WriteFile(minifiedFile, []byte("var a=1;"), 0644)
+	_ = os.WriteFile(sourceMapFile, []byte(`{"version":3}`), 0644)
+
+	gock.New(honeybadgerAPIHost).
+		Post(urlPath).
+		MatchHeader("X-API-Key", honeybadgerProjectAPIKey).
+		MatchHeader("Content-Type", "^multipart/form-data").
+		AddMatcher(func(req *http.Request, _ *gock.Request) (bool, error) {
+			err := req.ParseMultipartForm(1 << 20)
+			if err != nil {
+				return false, err
+			}
+			sourceMap, _, err := req.FormFile("source_map")
+			if err != nil {
+				return false, err
+			}
+			content, _ := ioutil.ReadAll(sourceMap)
+			return req.FormValue("revision") == "b6826b8" &&
+				req.FormValue("minified_url") == "https://cdn.sequra.es/app.min.js" &&
+				strings.Contains(string(content), "version"), nil
+		}).
+		Reply(http.StatusCreated)
+
+	errResponse := honeybadgerCli.UploadSourceMap(honeybadgerProjectAPIKey, "https://cdn.sequra.es/app.min.js", "b6826b8", minifiedFile, sourceMapFile)
+
+	assert.Equal(errResponse, nil, "Reponse error must be nil")
+	assert.True(gock.IsDone(), "Source map must be uploaded")
+}
+
+func TestUploadSourceMapWithMissingFile(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+
+	errResponse := honeybadgerCli.UploadSourceMap(honeybadgerProjectAPIKey, "https://cdn.sequra.es/app.min.js", "b6826b8", "/does/not/exist.js", "/does/not/exist.js.map")
+
+	assert.NotNil(errResponse, "Reponse error must not be nil")
+}
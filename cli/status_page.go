@@ -0,0 +1,96 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// GetStatusPage - Get a status page of an account
+func (hbc *HoneybadgerClient) GetStatusPage(accountID string, statusPageID int) (HoneybadgerStatusPage, error) {
+	var hbStatusPage HoneybadgerStatusPage
+
+	url := fmt.Sprintf("%s/v2/accounts/%s/status_pages/%d", hbc.HostURL, accountID, statusPageID)
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return HoneybadgerStatusPage{}, err
+	}
+
+	body, err := hbc.DoRequest(req)
+	if err != nil {
+		return HoneybadgerStatusPage{}, err
+	}
+
+	err = json.Unmarshal(body, &hbStatusPage)
+	if err != nil {
+		return HoneybadgerStatusPage{}, err
+	}
+
+	return hbStatusPage, nil
+}
+
+// CreateStatusPage - Create a status page in an account
+func (hbc *HoneybadgerClient) CreateStatusPage(accountID string, statusPage HoneybadgerStatusPage) (HoneybadgerStatusPage, error) {
+	var hbStatusPage HoneybadgerStatusPage
+
+	jsonPayload, err := json.Marshal(map[string]HoneybadgerStatusPage{"status_page": statusPage})
+	if err != nil {
+		return HoneybadgerStatusPage{}, err
+	}
+
+	url := fmt.Sprintf("%s/v2/accounts/%s/status_pages", hbc.HostURL, accountID)
+	req, err := http.NewRequest("POST", url, bytes.NewBuffer(jsonPayload))
+	if err != nil {
+		return HoneybadgerStatusPage{}, err
+	}
+
+	body, err := hbc.DoRequest(req)
+	if err != nil {
+		return HoneybadgerStatusPage{}, err
+	}
+
+	err = json.Unmarshal(body, &hbStatusPage)
+	if err != nil {
+		return HoneybadgerStatusPage{}, err
+	}
+
+	return hbStatusPage, nil
+}
+
+// UpdateStatusPage - Update a status page
+func (hbc *HoneybadgerClient) UpdateStatusPage(accountID string, statusPageID int, statusPage HoneybadgerStatusPage) error {
+	jsonPayload, err := json.Marshal(map[string]HoneybadgerStatusPage{"status_page": statusPage})
+	if err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("%s/v2/accounts/%s/status_pages/%d", hbc.HostURL, accountID, statusPageID)
+	req, err := http.NewRequest("PUT", url, bytes.NewBuffer(jsonPayload))
+	if err != nil {
+		return err
+	}
+
+	_, err = hbc.DoRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteStatusPage - Delete a status page
+func (hbc *HoneybadgerClient) DeleteStatusPage(accountID string, statusPageID int) error {
+	url := fmt.Sprintf("%s/v2/accounts/%s/status_pages/%d", hbc.HostURL, accountID, statusPageID)
+	req, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = hbc.DoRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
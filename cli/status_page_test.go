@@ -0,0 +1,88 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+	"net/http"
+	"testing"
+)
+
+var honeybadgerStatusPage = HoneybadgerStatusPage{
+	Name:     "Acme status",
+	Domain:   "status.example.com",
+	Public:   true,
+	Sites:    []HoneybadgerStatusPageItem{{ID: "abc123", DisplayName: "Website"}},
+	CheckIns: []HoneybadgerStatusPageItem{{ID: "xyz789", DisplayName: "Nightly backup"}},
+}
+
+func TestGetStatusPage(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+	statusPageID := 42
+	urlPath := fmt.Sprintf("/v2/accounts/%s/status_pages/%d", honeybadgerAccountID, statusPageID)
+
+	expectedResponse := honeybadgerStatusPage
+	expectedResponse.ID = statusPageID
+	expectedBody, _ := json.Marshal(expectedResponse)
+	gock.New(honeybadgerAPIHost).
+		Get(urlPath).
+		Reply(http.StatusOK).
+		JSON(expectedBody)
+
+	actualResponse, actualErrResponse := honeybadgerCli.GetStatusPage(honeybadgerAccountID, statusPageID)
+
+	assert.Equal(expectedResponse, actualResponse, "Actual response is different from expected response")
+	assert.Equal(actualErrResponse, nil, "Reponse error must be nil")
+}
+
+func TestCreateStatusPage(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+	urlPath := fmt.Sprintf("/v2/accounts/%s/status_pages", honeybadgerAccountID)
+
+	expectedResponse := honeybadgerStatusPage
+	expectedResponse.ID = 42
+	expectedBody, _ := json.Marshal(expectedResponse)
+	gock.New(honeybadgerAPIHost).
+		Post(urlPath).
+		BodyString(`{"status_page":{"name":"Acme status","domain":"status.example.com","public":true,"sites":[{"id":"abc123","display_name":"Website"}],"check_ins":[{"id":"xyz789","display_name":"Nightly backup"}]}}`).
+		Reply(http.StatusCreated).
+		JSON(expectedBody)
+
+	actualResponse, actualErrResponse := honeybadgerCli.CreateStatusPage(honeybadgerAccountID, honeybadgerStatusPage)
+
+	assert.Equal(expectedResponse, actualResponse, "Actual response is different from expected response")
+	assert.Equal(actualErrResponse, nil, "Reponse error must be nil")
+}
+
+func TestUpdateStatusPage(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+	statusPageID := 42
+	urlPath := fmt.Sprintf("/v2/accounts/%s/status_pages/%d", honeybadgerAccountID, statusPageID)
+
+	gock.New(honeybadgerAPIHost).
+		Put(urlPath).
+		Reply(http.StatusNoContent)
+
+	errResponse := honeybadgerCli.UpdateStatusPage(honeybadgerAccountID, statusPageID, honeybadgerStatusPage)
+
+	assert.Equal(errResponse, nil, "Reponse error must be nil")
+}
+
+func TestDeleteStatusPage(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+	statusPageID := 42
+	urlPath := fmt.Sprintf("/v2/accounts/%s/status_pages/%d", honeybadgerAccountID, statusPageID)
+
+	gock.New(honeybadgerAPIHost).
+		Delete(urlPath).
+		Reply(http.StatusNoContent)
+
+	errResponse := honeybadgerCli.DeleteStatusPage(honeybadgerAccountID, statusPageID)
+
+	assert.Equal(errResponse, nil, "Reponse error must be nil")
+}
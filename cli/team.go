@@ -12,7 +12,7 @@ import (
 func (hbc *HoneybadgerClient) GetTeams() ([]HoneybadgerTeam, error) {
 	var hbTeams HoneybadgerTeams
 
-	url := fmt.Sprintf("%s/v2/teams", hbc.HostURL)
+	url := hbc.accountScopedURL("teams")
 	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
 		return hbTeams.Teams, err
@@ -31,6 +31,9 @@ func (hbc *HoneybadgerClient) GetTeams() ([]HoneybadgerTeam, error) {
 	return hbTeams.Teams, nil
 }
 
+// ErrTeamNotFound - The team is not listed, e.g. once it is deleted
+var ErrTeamNotFound = errors.New("Team not found")
+
 // FindTeamByName - Find Team by name
 func (hbc *HoneybadgerClient) FindTeamByName(teamName string) (HoneybadgerTeam, error) {
 	hbTeams, err := hbc.GetTeams()
@@ -43,7 +46,7 @@ func (hbc *HoneybadgerClient) FindTeamByName(teamName string) (HoneybadgerTeam,
 			return team, nil
 		}
 	}
-	return HoneybadgerTeam{}, errors.New("Team not found")
+	return HoneybadgerTeam{}, ErrTeamNotFound
 }
 
 // FindTeamByID - Find Team by ID
@@ -58,7 +61,7 @@ func (hbc *HoneybadgerClient) FindTeamByID(teamID int) (HoneybadgerTeam, error)
 			return team, nil
 		}
 	}
-	return HoneybadgerTeam{}, errors.New("Team not found")
+	return HoneybadgerTeam{}, ErrTeamNotFound
 }
 
 // CreateTeam - Create Team
@@ -66,7 +69,7 @@ func (hbc *HoneybadgerClient) CreateTeam(teamName string) (HoneybadgerTeam, erro
 	var hbTeam HoneybadgerTeam
 	var jsonPayload = []byte(`{"team":{"name":"` + teamName + `"}}`)
 
-	url := fmt.Sprintf("%s/v2/teams", hbc.HostURL)
+	url := hbc.accountScopedURL("teams")
 	req, err := http.NewRequest("POST", url, bytes.NewBuffer(jsonPayload))
 	if err != nil {
 		return HoneybadgerTeam{}, err
@@ -174,6 +174,7 @@ func TestFindTeamByIDNotFound(t *testing.T) {
 
 	assert.Equal(HoneybadgerTeam{}, actualHoneybadgerResponse, "Actual response is different from expected response")
 	assert.Equal(errResponse, expectedErrorResponse, "Reponse error must be nil")
+	assert.ErrorIs(errResponse, ErrTeamNotFound, "Reponse error must match ErrTeamNotFound")
 }
 
 func TestCreateTeam(t *testing.T) {
@@ -226,3 +227,48 @@ func TestDeleteTeam(t *testing.T) {
 
 	assert.Equal(errResponse, nil, "Reponse error must be nil")
 }
+
+func TestGetTeamsScopedToAccount(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+	urlPath := "/v2/teams"
+
+	accountCli := NewClient(&honeybadgerAPIHost, &honeybadgerAPIKey)
+	accountCli.AccountID = honeybadgerAccountID
+
+	expectedHoneybadgerResponse := HoneybadgerTeams{
+		Teams: []HoneybadgerTeam{{ID: 1234, Name: "Test Sequra Team"}},
+	}
+	expectedBody, _ := json.Marshal(expectedHoneybadgerResponse)
+	gock.New(honeybadgerAPIHost).
+		Get(urlPath).
+		MatchParam("account_id", honeybadgerAccountID).
+		Reply(http.StatusOK).
+		JSON(expectedBody)
+
+	actualHoneybadgerResponse, errResponse := accountCli.GetTeams()
+
+	assert.Equal(expectedHoneybadgerResponse.Teams, actualHoneybadgerResponse, "Actual response is different from expected response")
+	assert.Equal(errResponse, nil, "Reponse error must be nil")
+}
+
+func TestCreateTeamScopedToAccount(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+	urlPath := "/v2/teams"
+
+	accountCli := NewClient(&honeybadgerAPIHost, &honeybadgerAPIKey)
+	accountCli.AccountID = honeybadgerAccountID
+
+	expectedBody, _ := json.Marshal(nil)
+	gock.New(honeybadgerAPIHost).
+		Post(urlPath).
+		MatchParam("account_id", honeybadgerAccountID).
+		Reply(http.StatusCreated).
+		JSON(expectedBody)
+
+	_, errResponse := accountCli.CreateTeam("New Team")
+
+	assert.Equal(errResponse, nil, "Reponse error must be nil")
+	assert.True(gock.IsDone(), "Team must be created in the configured account")
+}
@@ -9,12 +9,11 @@ import (
 	"strconv"
 )
 
-// GetUsersPaginated - Returns all registered users in Honeybadger using pagination
+// GetUsersPaginated - Returns all registered users in Honeybadger using pagination, appending every page to hbUserList
 func (hbc *HoneybadgerClient) GetUsersPaginated(pagePath string, hbUserList []HoneybadgerUser) ([]HoneybadgerUser, error) {
 	var hbUsers HoneybadgerUsers
-	urlPath := fmt.Sprintf("%s/%s", hbc.HostURL, pagePath)
 
-	req, err := http.NewRequest("GET", urlPath, nil)
+	req, err := http.NewRequest("GET", hbc.pageURL(pagePath), nil)
 	if err != nil {
 		return hbUsers.Users, err
 	}
@@ -29,12 +28,12 @@ func (hbc *HoneybadgerClient) GetUsersPaginated(pagePath string, hbUserList []Ho
 		return hbUsers.Users, err
 	}
 
+	hbUserList = append(hbUserList, hbUsers.Users...)
+
 	if hbUsers.Links.NextPage != "" {
-		return hbc.GetUsersPaginated(hbUsers.Links.NextPage, hbUsers.Users)
+		return hbc.GetUsersPaginated(hbUsers.Links.NextPage, hbUserList)
 	}
 
-	hbUserList = append(hbUserList, hbUsers.Users...)
-
 	return hbUserList, nil
 }
 
@@ -104,6 +103,40 @@ func (hbc *HoneybadgerClient) DeleteUser(userID int, teamID int) error {
 	return nil
 }
 
+// UpdateTeamInvitation - Update the admin flag of a pending invitation to a Team
+func (hbc *HoneybadgerClient) UpdateTeamInvitation(invitationID int, isAdmin bool, teamID int) error {
+	var jsonPayload = []byte(`{"team_invitation":{"admin":` + strconv.FormatBool(isAdmin) + `}}`)
+
+	url := fmt.Sprintf("%s/v2/teams/%d/team_invitations/%d", hbc.HostURL, teamID, invitationID)
+	req, err := http.NewRequest("PUT", url, bytes.NewBuffer(jsonPayload))
+	if err != nil {
+		return err
+	}
+
+	_, err = hbc.DoRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteTeamInvitation - Cancel a pending invitation to a Team
+func (hbc *HoneybadgerClient) DeleteTeamInvitation(invitationID int, teamID int) error {
+	url := fmt.Sprintf("%s/v2/teams/%d/team_invitations/%d", hbc.HostURL, teamID, invitationID)
+	req, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = hbc.DoRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // GetUserFromTeams - Get Users information from Teams
 func (hbc *HoneybadgerClient) GetUserFromTeams(userEmail string) (userTeams []HoneybadgerUser, err error) {
 	var insertedUser bool
@@ -135,6 +168,7 @@ func (hbc *HoneybadgerClient) GetUserFromTeams(userEmail string) (userTeams []Ho
 							IsAdmin:   userInvitation.IsAdmin,
 							TeamID:    team.ID,
 							CreatedAt: userInvitation.CreatedAt,
+							IsPending: true,
 						},
 					)
 				}
@@ -145,6 +179,9 @@ func (hbc *HoneybadgerClient) GetUserFromTeams(userEmail string) (userTeams []Ho
 	return userTeams, nil
 }
 
+// ErrUserNotFound - The user is neither a member of the team nor invited to it
+var ErrUserNotFound = errors.New("user not found")
+
 // GetUserForTeam - Get User information from specific Team
 func (hbc *HoneybadgerClient) GetUserForTeam(userEmail string, teamID int) (HoneybadgerUser, error) {
 	userTeams, err := hbc.GetUserFromTeams(userEmail)
@@ -158,5 +195,24 @@ func (hbc *HoneybadgerClient) GetUserForTeam(userEmail string, teamID int) (Hone
 		}
 	}
 
-	return HoneybadgerUser{}, errors.New("User " + userEmail + "not found in team " + strconv.Itoa(teamID))
+	return HoneybadgerUser{}, fmt.Errorf("User %s not found in team %d: %w", userEmail, teamID, ErrUserNotFound)
+}
+
+// FindTeamMemberByEmail - Find a user that already joined any Team, ignoring pending invitations
+func (hbc *HoneybadgerClient) FindTeamMemberByEmail(userEmail string) (HoneybadgerUser, error) {
+	teams, err := hbc.GetTeams()
+	if err != nil {
+		return HoneybadgerUser{}, err
+	}
+
+	for _, team := range teams {
+		for _, user := range team.Users {
+			if user.Email == userEmail {
+				user.TeamID = team.ID
+				return user, nil
+			}
+		}
+	}
+
+	return HoneybadgerUser{}, errors.New("User " + userEmail + " not found in any team")
 }
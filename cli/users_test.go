@@ -127,6 +127,56 @@ func TestGetUsersWithPagination(t *testing.T) {
 	assert.Equal(errResponse, nil, "Reponse error must be nil")
 }
 
+func TestGetUsersWithSeveralPages(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+
+	var hbExpectedUserList []HoneybadgerUser
+
+	expectedPaginatedResponse := []struct {
+		hbUsers HoneybadgerUsers
+		urlPath string
+	}{
+		{
+			urlPath: fmt.Sprintf("/v2/teams/%d/team_members", honeybadgerTeamID),
+			hbUsers: HoneybadgerUsers{
+				Users: []HoneybadgerUser{{ID: 1, Name: "Test Sequra Page1", Email: "test.sequra.page1@sequra.es"}},
+				Links: HoneybadgerLink{NextPage: "/v2/teams/23434/team_members?page=2"},
+			},
+		},
+		{
+			urlPath: fmt.Sprintf("/v2/teams/%d/team_members", honeybadgerTeamID),
+			hbUsers: HoneybadgerUsers{
+				Users: []HoneybadgerUser{{ID: 2, Name: "Test Sequra Page2", Email: "test.sequra.page2@sequra.es"}},
+				Links: HoneybadgerLink{NextPage: "http://localhost/v2/teams/23434/team_members?page=3"},
+			},
+		},
+		{
+			urlPath: fmt.Sprintf("/v2/teams/%d/team_members", honeybadgerTeamID),
+			hbUsers: HoneybadgerUsers{
+				Users: []HoneybadgerUser{{ID: 3, Name: "Test Sequra Page3", Email: "test.sequra.page3@sequra.es", IsAdmin: true}},
+			},
+		},
+	}
+
+	for i, expectedResponse := range expectedPaginatedResponse {
+		expectedBodyPage, _ := json.Marshal(expectedResponse.hbUsers)
+		request := gock.New(honeybadgerAPIHost).Get(expectedResponse.urlPath)
+		if i > 0 {
+			request = request.MatchParam("page", fmt.Sprint(i+1))
+		}
+		request.Reply(http.StatusOK).JSON(expectedBodyPage)
+
+		hbExpectedUserList = append(hbExpectedUserList, expectedResponse.hbUsers.Users...)
+	}
+
+	actualHoneybadgerResponse, errResponse := honeybadgerCli.GetUsers(honeybadgerTeamID)
+
+	assert.Equal(hbExpectedUserList, actualHoneybadgerResponse, "Every page must be returned")
+	assert.Equal(errResponse, nil, "Reponse error must be nil")
+	assert.True(gock.IsDone(), "Every page must be requested")
+}
+
 func TestCreateUser(t *testing.T) {
 	defer gock.Off()
 	assert := assert.New(t)
@@ -178,6 +228,37 @@ func TestDeleteUser(t *testing.T) {
 	assert.Equal(errResponse, nil, "Reponse error must be nil")
 }
 
+func TestUpdateTeamInvitation(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+	invitationID := 9
+	urlPath := fmt.Sprintf("/v2/teams/%d/team_invitations/%d", honeybadgerTeamID, invitationID)
+
+	gock.New(honeybadgerAPIHost).
+		Put(urlPath).
+		BodyString(`{"team_invitation":{"admin":true}}`).
+		Reply(http.StatusNoContent)
+
+	errResponse := honeybadgerCli.UpdateTeamInvitation(invitationID, true, honeybadgerTeamID)
+
+	assert.Equal(errResponse, nil, "Reponse error must be nil")
+}
+
+func TestDeleteTeamInvitation(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+	invitationID := 9
+	urlPath := fmt.Sprintf("/v2/teams/%d/team_invitations/%d", honeybadgerTeamID, invitationID)
+
+	gock.New(honeybadgerAPIHost).
+		Delete(urlPath).
+		Reply(http.StatusNoContent)
+
+	errResponse := honeybadgerCli.DeleteTeamInvitation(invitationID, honeybadgerTeamID)
+
+	assert.Equal(errResponse, nil, "Reponse error must be nil")
+}
+
 func TestGetUserFromTeamsWithPendingInvitations(t *testing.T) {
 	defer gock.Off()
 	assert := assert.New(t)
@@ -271,10 +352,11 @@ func TestGetUserFromTeamsWithPendingInvitations(t *testing.T) {
 					TeamID:  992,
 				},
 				{
-					ID:      userID,
-					Email:   "test.sequra.page2@sequra.es",
-					IsAdmin: false,
-					TeamID:  993,
+					ID:        userID,
+					Email:     "test.sequra.page2@sequra.es",
+					IsAdmin:   false,
+					TeamID:    993,
+					IsPending: true,
 				},
 			},
 		},
@@ -282,16 +364,18 @@ func TestGetUserFromTeamsWithPendingInvitations(t *testing.T) {
 			email: "test.sequra.invitation@sequra.es",
 			response: []HoneybadgerUser{
 				{
-					ID:      userID,
-					Email:   "test.sequra.invitation@sequra.es",
-					IsAdmin: false,
-					TeamID:  991,
+					ID:        userID,
+					Email:     "test.sequra.invitation@sequra.es",
+					IsAdmin:   false,
+					TeamID:    991,
+					IsPending: true,
 				},
 				{
-					ID:      userID,
-					Email:   "test.sequra.invitation@sequra.es",
-					IsAdmin: false,
-					TeamID:  992,
+					ID:        userID,
+					Email:     "test.sequra.invitation@sequra.es",
+					IsAdmin:   false,
+					TeamID:    992,
+					IsPending: true,
 				},
 			},
 		},
@@ -354,3 +438,79 @@ func TestGetUserForTeam(t *testing.T) {
 	assert.Equal(expectedHoneybadgerTeamUserResponse, actualResponse, "Actual response is different from expected response")
 	assert.Equal(actualErrResponse, nil, "Reponse error does not match")
 }
+
+func TestGetUserForTeamNotFound(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+	teamID := 999
+
+	expectedHoneyResponse := HoneybadgerTeams{
+		Teams: []HoneybadgerTeam{{ID: teamID, Name: "Test Sequra Team"}},
+	}
+	expectedBody, _ := json.Marshal(expectedHoneyResponse)
+	gock.New(honeybadgerAPIHost).
+		Get("/v2/teams").
+		Reply(http.StatusOK).
+		JSON(expectedBody)
+
+	_, actualErrResponse := honeybadgerCli.GetUserForTeam("test.sequra.page2@sequra.es", teamID)
+
+	assert.True(errors.Is(actualErrResponse, ErrUserNotFound), "Reponse error must be ErrUserNotFound")
+}
+
+func TestFindTeamMemberByEmail(t *testing.T) {
+	defer gock.Off()
+	assert := assert.New(t)
+
+	mockTeamResponse := HoneybadgerTeams{
+		Teams: []HoneybadgerTeam{
+			{
+				ID:   991,
+				Name: "Test Sequra Team",
+				Invitations: []HoneybadgerInvitation{
+					{
+						ID:    9,
+						Email: "test.sequra.invitation@sequra.es",
+					},
+				},
+			},
+			{
+				ID:   992,
+				Name: "Test Sequra Team",
+				Users: []HoneybadgerUser{
+					{
+						ID:    945,
+						Email: "test.sequra@sequra.es",
+					},
+				},
+			},
+		},
+	}
+	expectedBody, _ := json.Marshal(mockTeamResponse)
+
+	expectedResponses := []struct {
+		response HoneybadgerUser
+		email    string
+		err      error
+	}{
+		{
+			email:    "test.sequra@sequra.es",
+			response: HoneybadgerUser{ID: 945, Email: "test.sequra@sequra.es", TeamID: 992},
+		},
+		{
+			email: "test.sequra.invitation@sequra.es",
+			err:   errors.New("User test.sequra.invitation@sequra.es not found in any team"),
+		},
+	}
+
+	for _, expectedResponse := range expectedResponses {
+		gock.New(honeybadgerAPIHost).
+			Get("/v2/teams").
+			Reply(http.StatusOK).
+			JSON(expectedBody)
+
+		actualResponse, actualErrResponse := honeybadgerCli.FindTeamMemberByEmail(expectedResponse.email)
+		assert.Equal(expectedResponse.response, actualResponse, "Actual response is different from expected response")
+		assert.Equal(expectedResponse.err, actualErrResponse, "Reponse error does not match")
+	}
+}
@@ -26,19 +26,46 @@ resource "honeybadger_team" "new_team" { # terraform import honeybadger_team.new
 # Create a new user
 resource "honeybadger_user" "new" {
   email = "test.sequra@sequra.es"
-  team {
-    id = honeybadger_team.new_team.id
-    is_admin = false
-  }
+  team = [
+    {
+      id       = honeybadger_team.new_team.id
+      is_admin = false
+    },
+  ]
 }
 
 
 # Update user - before you need to import using: terraform import honeybadger_user.TestSequra2 test.sequra.page2@sequra.es
 resource "honeybadger_user" "TestSequra2" {
   email = "test.sequra.page2@sequra.es"
-  team {
-    id = honeybadger_team.new_team.id
-    is_admin = false
-  }
+  team = [
+    {
+      id       = honeybadger_team.new_team.id
+      is_admin = false
+    },
+  ]
+}
+
+
+# Unresolved production faults, e.g. to gate a release
+data "honeybadger_faults" "unresolved" {
+  project_id = 1234
+  q          = "-is:resolved -is:ignored environment:production"
+}
+output "unresolved_faults" {
+  value = length(data.honeybadger_faults.unresolved.faults)
 }
 
+# Error rate of the last day from Insights
+data "honeybadger_insights_query" "errors_by_status" {
+  project_id = 1234
+  ts         = "P1D"
+  query      = <<-EOQ
+    fields status
+    | filter status >= 500
+    | stats count() as errors
+  EOQ
+}
+output "daily_errors" {
+  value = tonumber(data.honeybadger_insights_query.errors_by_status.results[0]["errors"])
+}
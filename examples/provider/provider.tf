@@ -9,3 +9,16 @@ terraform {
 provider "honeybadger" {
   api_key = "<INTRODUCE_YOUR_API_KEY>"
 }
+
+# One provider per Honeybadger account, so a token with access to several
+# accounts does not mix their projects and teams
+provider "honeybadger" {
+  alias      = "eu"
+  api_key    = "<INTRODUCE_YOUR_API_KEY>"
+  account_id = "<EU_ACCOUNT_ID>"
+}
+
+resource "honeybadger_team" "eu_backend" {
+  provider = honeybadger.eu
+  name     = "Backend"
+}
@@ -0,0 +1,6 @@
+# Invite a user to the account
+resource "honeybadger_account_user" "new" { # terraform import honeybadger_account_user.new abcdef/test.sequra@sequra.es
+  account_id = "abcdef"
+  email      = "test.sequra@sequra.es"
+  role       = "member"
+}
@@ -0,0 +1,14 @@
+# Get notified when checkout requests start failing
+resource "honeybadger_alarm" "checkout_errors" { # terraform import honeybadger_alarm.checkout_errors 1234/42
+  project_id        = honeybadger_project.new_project.id
+  name              = "Checkout errors"
+  query             = "filter request.path == '/checkout' and response.status >= 500 | stats count()"
+  evaluation_period = "5m"
+  lookback          = "1m"
+  integration_ids   = [7]
+
+  trigger {
+    comparison = "gt"
+    threshold  = 10
+  }
+}
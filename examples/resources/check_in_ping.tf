@@ -0,0 +1,9 @@
+# Report the nightly backup check-in once when it is deployed, so it starts green
+resource "honeybadger_check_in_ping" "nightly_backup" {
+  check_in_id = "xyz789"
+
+  # Ping again whenever a new version of the job is deployed
+  triggers = {
+    job_version = "1.4.2"
+  }
+}
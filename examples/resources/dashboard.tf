@@ -0,0 +1,19 @@
+# Create an Insights dashboard per service
+resource "honeybadger_dashboard" "checkout" { # terraform import honeybadger_dashboard.checkout 1234/42
+  project_id = honeybadger_project.new_project.id
+  title      = "Checkout service"
+
+  widget {
+    title = "Errors per hour"
+    query = "stats count() by bin(1h)"
+    width = 9
+  }
+
+  widget {
+    title         = "Errors"
+    query         = "stats count()"
+    visualization = "number"
+    ts            = "P1D"
+    width         = 3
+  }
+}
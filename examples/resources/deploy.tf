@@ -0,0 +1,8 @@
+# Record a deploy every time a new revision is rolled out
+resource "honeybadger_deploy" "production" {
+  api_key        = honeybadger_project.new_project.api_key
+  environment    = "production"
+  revision       = var.image_tag
+  repository     = "git@github.com:sequra/app.git"
+  local_username = "terraform"
+}
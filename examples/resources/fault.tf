@@ -0,0 +1,7 @@
+# Ignore a known noisy fault
+resource "honeybadger_fault" "noisy_timeout" { # terraform import honeybadger_fault.noisy_timeout 1234/999
+  project_id     = honeybadger_project.new_project.id
+  fault_id       = 999
+  ignored        = true
+  assignee_email = "test.sequra@sequra.es"
+}
@@ -0,0 +1,6 @@
+# Link the runbook of a known fault
+resource "honeybadger_fault_comment" "timeouts_runbook" { # terraform import honeybadger_fault_comment.timeouts_runbook 1234/999/1
+  project_id = honeybadger_project.new_project.id
+  fault_id   = 999
+  body       = "Runbook: https://wiki.sequra.es/runbooks/timeouts"
+}
@@ -0,0 +1,8 @@
+# Upload the source map of a frontend build
+resource "honeybadger_source_map" "app" {
+  api_key       = honeybadger_project.frontend.api_key
+  minified_url  = "https://cdn.sequra.es/assets/app.min.js"
+  revision      = var.release
+  minified_file = "${path.module}/dist/app.min.js"
+  source_map    = "${path.module}/dist/app.min.js.map"
+}
@@ -0,0 +1,17 @@
+# Publish the uptime of the website and the nightly backup
+resource "honeybadger_status_page" "acme" { # terraform import honeybadger_status_page.acme abcdef/42
+  account_id = "abcdef"
+  name       = "Acme status"
+  domain     = "status.example.com"
+  visibility = "public"
+
+  site {
+    id           = "abc123"
+    display_name = "Website"
+  }
+
+  check_in {
+    id           = "xyz789"
+    display_name = "Nightly backup"
+  }
+}
@@ -0,0 +1,6 @@
+# Add a user to the team owned by this configuration only
+resource "honeybadger_team_member" "backend_jane" { # terraform import honeybadger_team_member.backend_jane 1234/jane@sequra.es
+  team_id = honeybadger_team.new_team.id
+  email   = "jane@sequra.es"
+  admin   = true
+}
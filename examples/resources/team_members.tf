@@ -0,0 +1,17 @@
+# Own the whole member list of a team, anyone else is removed from it
+resource "honeybadger_team_members" "backend" { # terraform import honeybadger_team_members.backend 1234
+  team_id = honeybadger_team.new_team.id
+
+  member = [
+    {
+      email = "jane@sequra.es"
+      admin = true
+    },
+    {
+      email = "john@sequra.es"
+    },
+  ]
+
+  # Only warn about the members that would be removed, e.g. while adopting an existing team
+  dry_run_removals = true
+}
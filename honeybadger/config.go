@@ -0,0 +1,133 @@
+package honeybadger
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+
+	"terraform-provider-honeybadger/cli"
+)
+
+// providerConfig - Provider settings, resolved from the configuration and the environment. The SDK
+// and the framework providers both build their client from it, so they always talk to the same API
+type providerConfig struct {
+	Region        string
+	Host          string
+	ReportingHost string
+	APIKey        string
+	APIKeyFile    string
+	APIKeyCommand []string
+	AccountID     string
+	// SkipCredentialsValidation - Do not call the API at configure time, e.g. for offline plans
+	SkipCredentialsValidation bool
+}
+
+// newProviderClient - Builds the Honeybadger client shared by the SDK and the framework resources. A wrong
+// setting is reported as a *cli.CredentialsError naming it
+func newProviderClient(ctx context.Context, config providerConfig) (*cli.HoneybadgerClient, error) {
+	apiKey, err := resolveAPIKey(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	if apiKey == "" {
+		return nil, &cli.CredentialsError{Setting: "api_key", Reason: "Honeybadger Client cannot be created because 'api_key' provider parameter is not defined"}
+	}
+
+	c := cli.NewClient(&config.Host, &apiKey)
+	c.AccountID = config.AccountID
+
+	// An explicit host overrides the region, for both APIs unless reporting_host is set too
+	if config.Host == "" {
+		hostURL, reportingURL, err := cli.RegionURLs(config.Region)
+		if err != nil {
+			return nil, &cli.CredentialsError{Setting: "region", Reason: err.Error()}
+		}
+		c.HostURL = hostURL
+		c.ReportingURL = reportingURL
+	}
+	if config.ReportingHost != "" {
+		c.ReportingURL = config.ReportingHost
+	}
+
+	if !config.SkipCredentialsValidation {
+		err := c.WithContext(ctx).ValidateCredentials()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
+
+// resolveAPIKey - The first source that is set wins, in this order: api_key, api_key_file and
+// api_key_command in the provider block, then the HONEYBADGER_API_KEY and HONEYBADGER_API_KEY_FILE
+// environment variables
+func resolveAPIKey(ctx context.Context, config providerConfig) (string, error) {
+	if config.APIKey != "" {
+		return config.APIKey, nil
+	}
+	if config.APIKeyFile != "" {
+		return readAPIKeyFile(config.APIKeyFile)
+	}
+	if len(config.APIKeyCommand) > 0 {
+		return runAPIKeyCommand(ctx, config.APIKeyCommand)
+	}
+	if apiKey := os.Getenv("HONEYBADGER_API_KEY"); apiKey != "" {
+		return apiKey, nil
+	}
+	if apiKeyFile := os.Getenv("HONEYBADGER_API_KEY_FILE"); apiKeyFile != "" {
+		return readAPIKeyFile(apiKeyFile)
+	}
+
+	return "", nil
+}
+
+// readAPIKeyFile - Secrets rendered by agents such as Vault Agent usually end with a newline
+func readAPIKeyFile(apiKeyFile string) (string, error) {
+	content, err := ioutil.ReadFile(apiKeyFile)
+	if err != nil {
+		return "", &cli.CredentialsError{Setting: "api_key_file", Reason: err.Error()}
+	}
+
+	apiKey := strings.TrimSpace(string(content))
+	if apiKey == "" {
+		return "", &cli.CredentialsError{Setting: "api_key_file", Reason: fmt.Sprintf("%s is empty", apiKeyFile)}
+	}
+
+	return apiKey, nil
+}
+
+// apiKeyCommandOutputs - The SDK and the framework providers are configured separately, the credential
+// helper only runs for the first one
+var apiKeyCommandOutputs sync.Map
+
+// runAPIKeyCommand - The credential helper prints the API key on its standard output
+func runAPIKeyCommand(ctx context.Context, apiKeyCommand []string) (string, error) {
+	var stdout, stderr bytes.Buffer
+
+	key := strings.Join(apiKeyCommand, "\x00")
+	if apiKey, ok := apiKeyCommandOutputs.Load(key); ok {
+		return apiKey.(string), nil
+	}
+
+	cmd := exec.CommandContext(ctx, apiKeyCommand[0], apiKeyCommand[1:]...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	err := cmd.Run()
+	if err != nil {
+		return "", &cli.CredentialsError{Setting: "api_key_command", Reason: fmt.Sprintf("%s: %s", err, strings.TrimSpace(stderr.String()))}
+	}
+
+	apiKey := strings.TrimSpace(stdout.String())
+	if apiKey == "" {
+		return "", &cli.CredentialsError{Setting: "api_key_command", Reason: fmt.Sprintf("%s printed no API key", apiKeyCommand[0])}
+	}
+	apiKeyCommandOutputs.Store(key, apiKey)
+
+	return apiKey, nil
+}
@@ -0,0 +1,144 @@
+package honeybadger
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"terraform-provider-honeybadger/cli"
+)
+
+func TestResolveAPIKeyPrecedence(t *testing.T) {
+	ctx := context.Background()
+
+	apiKeyFile := filepath.Join(t.TempDir(), "api_key")
+	if err := ioutil.WriteFile(apiKeyFile, []byte("from-file\n"), 0600); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	envAPIKeyFile := filepath.Join(t.TempDir(), "env_api_key")
+	if err := ioutil.WriteFile(envAPIKeyFile, []byte("from-env-file\n"), 0600); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	cases := []struct {
+		name     string
+		config   providerConfig
+		env      map[string]string
+		expected string
+	}{
+		{
+			name:     "api_key wins over everything",
+			config:   providerConfig{APIKey: "from-block", APIKeyFile: apiKeyFile, APIKeyCommand: []string{"echo", "from-command"}},
+			env:      map[string]string{"HONEYBADGER_API_KEY": "from-env"},
+			expected: "from-block",
+		},
+		{
+			name:     "api_key_file wins over api_key_command",
+			config:   providerConfig{APIKeyFile: apiKeyFile, APIKeyCommand: []string{"echo", "from-command"}},
+			expected: "from-file",
+		},
+		{
+			name:     "api_key_command wins over the environment",
+			config:   providerConfig{APIKeyCommand: []string{"echo", "from-command"}},
+			env:      map[string]string{"HONEYBADGER_API_KEY": "from-env"},
+			expected: "from-command",
+		},
+		{
+			name:     "HONEYBADGER_API_KEY wins over HONEYBADGER_API_KEY_FILE",
+			env:      map[string]string{"HONEYBADGER_API_KEY": "from-env", "HONEYBADGER_API_KEY_FILE": envAPIKeyFile},
+			expected: "from-env",
+		},
+		{
+			name:     "HONEYBADGER_API_KEY_FILE is the last resort",
+			env:      map[string]string{"HONEYBADGER_API_KEY_FILE": envAPIKeyFile},
+			expected: "from-env-file",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Setenv("HONEYBADGER_API_KEY", "")
+			t.Setenv("HONEYBADGER_API_KEY_FILE", "")
+			for k, v := range c.env {
+				t.Setenv(k, v)
+			}
+
+			apiKey, err := resolveAPIKey(ctx, c.config)
+			if err != nil {
+				t.Fatalf("err: %s", err)
+			}
+			if apiKey != c.expected {
+				t.Fatalf("expected API key %q, got %q", c.expected, apiKey)
+			}
+		})
+	}
+}
+
+func TestResolveAPIKeyFailingCommand(t *testing.T) {
+	t.Setenv("HONEYBADGER_API_KEY", "")
+
+	_, err := resolveAPIKey(context.Background(), providerConfig{APIKeyCommand: []string{"false"}})
+
+	credentialsErr, ok := err.(*cli.CredentialsError)
+	if !ok {
+		t.Fatalf("expected a CredentialsError, got %v", err)
+	}
+	if credentialsErr.Setting != "api_key_command" {
+		t.Fatalf("expected api_key_command to be reported, got %s", credentialsErr.Setting)
+	}
+}
+
+func TestNewProviderClientHosts(t *testing.T) {
+	ctx := context.Background()
+
+	cases := []struct {
+		name         string
+		config       providerConfig
+		hostURL      string
+		reportingURL string
+	}{
+		{
+			name:         "us is the default region",
+			config:       providerConfig{},
+			hostURL:      cli.HoneybadgerURL,
+			reportingURL: cli.HoneybadgerReportingURL,
+		},
+		{
+			name:         "eu region",
+			config:       providerConfig{Region: "eu"},
+			hostURL:      cli.HoneybadgerEUURL,
+			reportingURL: cli.HoneybadgerEUReportingURL,
+		},
+		{
+			name:         "host overrides the region for both APIs",
+			config:       providerConfig{Region: "eu", Host: "http://localhost:8080"},
+			hostURL:      "http://localhost:8080",
+			reportingURL: "http://localhost:8080",
+		},
+		{
+			name:         "reporting_host overrides the reporting API only",
+			config:       providerConfig{Region: "eu", ReportingHost: "http://localhost:8081"},
+			hostURL:      cli.HoneybadgerEUURL,
+			reportingURL: "http://localhost:8081",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			c.config.APIKey = "hbp_123"
+			c.config.SkipCredentialsValidation = true
+
+			client, err := newProviderClient(ctx, c.config)
+			if err != nil {
+				t.Fatalf("err: %s", err)
+			}
+			if client.HostURL != c.hostURL {
+				t.Fatalf("expected data API host %s, got %s", c.hostURL, client.HostURL)
+			}
+			if client.ReportingURL != c.reportingURL {
+				t.Fatalf("expected reporting API host %s, got %s", c.reportingURL, client.ReportingURL)
+			}
+		})
+	}
+}
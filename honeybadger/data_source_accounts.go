@@ -0,0 +1,81 @@
+package honeybadger
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	hbc "terraform-provider-honeybadger/cli"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAccountsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*hbc.HoneybadgerClient).WithContext(ctx)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	accounts, err := c.GetAccounts()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var unstructuredAccounts []map[string]interface{}
+
+	for _, account := range accounts {
+		unstructuredAccounts = append(unstructuredAccounts, map[string]interface{}{
+			"id":        account.ID,
+			"name":      account.Name,
+			"email":     account.Email,
+			"active":    account.IsActive,
+			"parent_id": account.ParentID,
+		})
+	}
+
+	if err := d.Set("accounts", unstructuredAccounts); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// always run
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+
+	return diags
+}
+
+func dataSourceAccounts() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAccountsRead,
+		Schema: map[string]*schema.Schema{
+			"accounts": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"email": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"active": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"parent_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
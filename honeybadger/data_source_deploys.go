@@ -0,0 +1,166 @@
+package honeybadger
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	hbc "terraform-provider-honeybadger/cli"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceDeploysRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*hbc.HoneybadgerClient).WithContext(ctx)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	projectID := d.Get("project_id").(int)
+	deploys, err := c.GetDeploys(projectID, hbc.HoneybadgerDeployQuery{
+		Environment:   d.Get("environment").(string),
+		LocalUsername: d.Get("local_username").(string),
+		CreatedAfter:  d.Get("created_after").(string),
+		CreatedBefore: d.Get("created_before").(string),
+		Limit:         d.Get("limit").(int),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var unstructuredDeploys []map[string]interface{}
+
+	for _, deploy := range deploys {
+		unstructuredDeploys = append(unstructuredDeploys, map[string]interface{}{
+			"id":             deploy.ID,
+			"revision":       deploy.Revision,
+			"repository":     deploy.Repository,
+			"environment":    deploy.Environment,
+			"local_username": deploy.LocalUsername,
+			"created_at":     deploy.CreatedAt,
+			"url":            deploy.URL,
+		})
+	}
+
+	latestRevisions, err := latestDeployRevisions(deploys)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("deploys", unstructuredDeploys); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("latest_revisions", latestRevisions); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// always run
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+
+	return diags
+}
+
+func dataSourceDeploys() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDeploysRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"environment": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"local_username": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"created_after": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only deploys created after this Unix timestamp.",
+			},
+			"created_before": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only deploys created before this Unix timestamp.",
+			},
+			"limit": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Maximum number of deploys to return. All matching deploys are returned when unset.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"latest_revisions": &schema.Schema{
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Revision of the most recent deploy, keyed by environment.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"deploys": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"revision": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"repository": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"environment": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"local_username": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"url": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// latestDeployRevisions - Revision of the most recent deploy of every environment. Timestamps are
+// parsed, as their precision and time zone may differ between deploys
+func latestDeployRevisions(deploys []hbc.HoneybadgerDeploy) (map[string]interface{}, error) {
+	latestRevisions := map[string]interface{}{}
+	latestCreatedAt := map[string]time.Time{}
+
+	for _, deploy := range deploys {
+		createdAt, err := time.Parse(time.RFC3339, deploy.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("invalid created_at of deploy %d: %s", deploy.ID, err)
+		}
+
+		latest, found := latestCreatedAt[deploy.Environment]
+		if !found || createdAt.After(latest) {
+			latestCreatedAt[deploy.Environment] = createdAt
+			latestRevisions[deploy.Environment] = deploy.Revision
+		}
+	}
+
+	return latestRevisions, nil
+}
@@ -0,0 +1,33 @@
+package honeybadger
+
+import (
+	"fmt"
+	"testing"
+
+	hbc "terraform-provider-honeybadger/cli"
+)
+
+func TestLatestDeployRevisions(t *testing.T) {
+	// Compared as strings, the first deploy of every environment would look the most recent
+	deploys := []hbc.HoneybadgerDeploy{
+		{ID: 1, Environment: "production", Revision: "a1b2c3d", CreatedAt: "2022-08-12T10:00:00+02:00"},
+		{ID: 2, Environment: "production", Revision: "b6826b8", CreatedAt: "2022-08-12T09:00:00Z"},
+		{ID: 3, Environment: "staging", Revision: "e4f5a6b", CreatedAt: "2022-08-12T09:00:00Z"},
+		{ID: 4, Environment: "staging", Revision: "c7d8e9f", CreatedAt: "2022-08-12T09:00:00.5Z"},
+	}
+
+	latestRevisions, err := latestDeployRevisions(deploys)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expectedRevisions := map[string]interface{}{"production": "b6826b8", "staging": "c7d8e9f"}
+	if fmt.Sprint(latestRevisions) != fmt.Sprint(expectedRevisions) {
+		t.Fatalf("latest revisions %v, expected %v", latestRevisions, expectedRevisions)
+	}
+
+	_, err = latestDeployRevisions([]hbc.HoneybadgerDeploy{{ID: 5, Environment: "production", CreatedAt: "yesterday"}})
+	if err == nil {
+		t.Fatal("expected an error for an invalid created_at")
+	}
+}
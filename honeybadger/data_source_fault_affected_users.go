@@ -0,0 +1,114 @@
+package honeybadger
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	hbc "terraform-provider-honeybadger/cli"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceFaultAffectedUsersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*hbc.HoneybadgerClient).WithContext(ctx)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	projectID := d.Get("project_id").(int)
+	faultID := d.Get("fault_id").(int)
+	// The limit only applies to the users list, the totals are computed from every affected user
+	affectedUsers, err := c.GetAffectedUsers(projectID, faultID, hbc.HoneybadgerNoticeQuery{
+		CreatedAfter:  d.Get("created_after").(string),
+		CreatedBefore: d.Get("created_before").(string),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var unstructuredUsers []map[string]interface{}
+	totalNotices := 0
+	limit := d.Get("limit").(int)
+
+	for _, affectedUser := range affectedUsers {
+		if limit == 0 || len(unstructuredUsers) < limit {
+			unstructuredUsers = append(unstructuredUsers, map[string]interface{}{
+				"user":  affectedUser.User,
+				"count": affectedUser.Count,
+			})
+		}
+		totalNotices += affectedUser.Count
+	}
+
+	if err := d.Set("users", unstructuredUsers); err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("total_users", len(affectedUsers))
+	d.Set("total_notices", totalNotices)
+
+	// always run
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+
+	return diags
+}
+
+func dataSourceFaultAffectedUsers() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceFaultAffectedUsersRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"fault_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"created_after": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only notices created after this Unix timestamp.",
+			},
+			"created_before": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only notices created before this Unix timestamp.",
+			},
+			"limit": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Maximum number of users to return.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"total_users": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of affected users, regardless of the limit.",
+			},
+			"total_notices": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of notices of all the affected users, regardless of the limit.",
+			},
+			"users": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"count": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,101 @@
+package honeybadger
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	hbc "terraform-provider-honeybadger/cli"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceFaultCommentsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*hbc.HoneybadgerClient).WithContext(ctx)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	projectID := d.Get("project_id").(int)
+	faultID := d.Get("fault_id").(int)
+	comments, err := c.GetComments(projectID, faultID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var unstructuredComments []map[string]interface{}
+
+	for _, comment := range comments {
+		unstructuredComments = append(unstructuredComments, map[string]interface{}{
+			"id":            comment.ID,
+			"event":         comment.Event,
+			"source":        comment.Source,
+			"notices_count": comment.NoticesCount,
+			"author":        comment.Author,
+			"body":          comment.Body,
+			"created_at":    comment.CreatedAt,
+		})
+	}
+
+	if err := d.Set("comments", unstructuredComments); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// always run
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+
+	return diags
+}
+
+func dataSourceFaultComments() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceFaultCommentsRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"fault_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"comments": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"event": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"notices_count": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"author": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"body": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
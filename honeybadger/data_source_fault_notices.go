@@ -0,0 +1,154 @@
+package honeybadger
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	hbc "terraform-provider-honeybadger/cli"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// backtraceSummaryFrames - Number of backtrace frames kept for every notice
+const backtraceSummaryFrames = 5
+
+func dataSourceFaultNoticesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*hbc.HoneybadgerClient).WithContext(ctx)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	projectID := d.Get("project_id").(int)
+	faultID := d.Get("fault_id").(int)
+	notices, err := c.GetNotices(projectID, faultID, hbc.HoneybadgerNoticeQuery{
+		CreatedAfter:  d.Get("created_after").(string),
+		CreatedBefore: d.Get("created_before").(string),
+		Limit:         d.Get("limit").(int),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var unstructuredNotices []map[string]interface{}
+
+	for _, notice := range notices {
+		var backtrace []string
+		for i, frame := range notice.Backtrace {
+			if i == backtraceSummaryFrames {
+				break
+			}
+			backtrace = append(backtrace, fmt.Sprintf("%s:%s in %s", frame.File, frame.Number, frame.Method))
+		}
+
+		unstructuredNotices = append(unstructuredNotices, map[string]interface{}{
+			"id":              notice.ID,
+			"message":         notice.Message,
+			"environment":     notice.EnvironmentName,
+			"url":             notice.URL,
+			"created_at":      notice.CreatedAt,
+			"request_url":     notice.Request.URL,
+			"component":       notice.Request.Component,
+			"action":          notice.Request.Action,
+			"request_context": string(notice.Request.Context),
+			"backtrace":       backtrace,
+		})
+	}
+
+	if err := d.Set("notices", unstructuredNotices); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// always run
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+
+	return diags
+}
+
+func dataSourceFaultNotices() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceFaultNoticesRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"fault_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"created_after": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only notices created after this Unix timestamp.",
+			},
+			"created_before": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only notices created before this Unix timestamp.",
+			},
+			"limit": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Maximum number of notices to return. All matching notices are returned when unset.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"notices": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"message": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"environment": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"url": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"request_url": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"component": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"action": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"request_context": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "JSON encoded context of the request, use `jsondecode` to read it.",
+						},
+						"backtrace": &schema.Schema{
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Top frames of the backtrace, formatted as `file:line in method`.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
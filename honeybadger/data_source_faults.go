@@ -0,0 +1,170 @@
+package honeybadger
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	hbc "terraform-provider-honeybadger/cli"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceFaultsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*hbc.HoneybadgerClient).WithContext(ctx)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	projectID := d.Get("project_id").(int)
+	faults, err := c.GetFaults(projectID, hbc.HoneybadgerFaultQuery{
+		Query:          d.Get("q").(string),
+		CreatedAfter:   d.Get("created_after").(string),
+		OccurredAfter:  d.Get("occurred_after").(string),
+		OccurredBefore: d.Get("occurred_before").(string),
+		Order:          d.Get("order").(string),
+		Limit:          d.Get("limit").(int),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var unstructuredFaults []map[string]interface{}
+
+	for _, fault := range faults {
+		unstructuredFault := map[string]interface{}{
+			"id":             fault.ID,
+			"class":          fault.Klass,
+			"message":        fault.Message,
+			"component":      fault.Component,
+			"action":         fault.Action,
+			"environment":    fault.Environment,
+			"notices_count":  fault.NoticesCount,
+			"resolved":       fault.IsResolved,
+			"ignored":        fault.IsIgnored,
+			"assignee_email": "",
+			"url":            fault.URL,
+			"created_at":     fault.CreatedAt,
+			"last_notice_at": fault.LastNoticeAt,
+		}
+		if fault.Assignee != nil {
+			unstructuredFault["assignee_email"] = fault.Assignee.Email
+		}
+		unstructuredFaults = append(unstructuredFaults, unstructuredFault)
+	}
+
+	if err := d.Set("faults", unstructuredFaults); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// always run
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+
+	return diags
+}
+
+func dataSourceFaults() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceFaultsRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"q": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Search query, using the same syntax as the Honeybadger UI, e.g. `-is:resolved environment:production`.",
+			},
+			"created_after": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only faults created after this Unix timestamp.",
+			},
+			"occurred_after": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only faults that occurred after this Unix timestamp.",
+			},
+			"occurred_before": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only faults that occurred before this Unix timestamp.",
+			},
+			"order": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Either `recent` or `frequent`.",
+				ValidateFunc: validation.StringInSlice([]string{"recent", "frequent"}, false),
+			},
+			"limit": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Maximum number of faults to return. All matching faults are returned when unset.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"faults": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"class": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"message": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"component": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"action": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"environment": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"notices_count": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"resolved": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"ignored": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"assignee_email": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"url": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_notice_at": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,125 @@
+package honeybadger
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	hbc "terraform-provider-honeybadger/cli"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceInsightsQueryRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*hbc.HoneybadgerClient).WithContext(ctx)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	projectID := d.Get("project_id").(int)
+	result, err := c.QueryInsights(projectID, hbc.HoneybadgerInsightsQuery{
+		Query:    d.Get("query").(string),
+		Ts:       d.Get("ts").(string),
+		Timezone: d.Get("timezone").(string),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var unstructuredResults []map[string]interface{}
+
+	for _, row := range result.Results {
+		unstructuredRow := map[string]interface{}{}
+		for field, value := range row {
+			unstructuredRow[field] = insightsValueToString(value)
+		}
+		unstructuredResults = append(unstructuredResults, unstructuredRow)
+	}
+
+	resultsJSON, err := json.Marshal(result.Results)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("results", unstructuredResults); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("fields", result.Meta.Fields); err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("results_json", string(resultsJSON))
+
+	// always run
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+
+	return diags
+}
+
+// insightsValueToString - Terraform maps hold a single type, so every value is returned as a string
+func insightsValueToString(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		encoded, _ := json.Marshal(v)
+		return string(encoded)
+	}
+}
+
+func dataSourceInsightsQuery() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceInsightsQueryRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"query": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "BadgerQL query.",
+			},
+			"ts": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ISO 8601 duration of the time range, ending now, e.g. `PT3H` or `P7D`.",
+			},
+			"timezone": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "IANA timezone used to bin timestamps, e.g. `Europe/Madrid`.",
+			},
+			"fields": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"results": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Rows returned by the query. Values are strings, use `tonumber` to compare them.",
+				Elem: &schema.Schema{
+					Type: schema.TypeMap,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+			"results_json": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Rows returned by the query, JSON encoded with their original types.",
+			},
+		},
+	}
+}
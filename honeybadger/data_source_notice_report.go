@@ -0,0 +1,117 @@
+package honeybadger
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	hbc "terraform-provider-honeybadger/cli"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceNoticeReportRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*hbc.HoneybadgerClient).WithContext(ctx)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	projectID := d.Get("project_id").(int)
+	report := d.Get("report").(string)
+	rows, err := c.GetNoticeReport(projectID, report, hbc.HoneybadgerReportQuery{
+		StartAt:     d.Get("start_at").(string),
+		StopAt:      d.Get("stop_at").(string),
+		Environment: d.Get("environment").(string),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var unstructuredRows []map[string]interface{}
+	counts := map[string]interface{}{}
+	total := 0
+
+	for _, row := range rows {
+		unstructuredRows = append(unstructuredRows, map[string]interface{}{
+			"key":   row.Key,
+			"count": row.Count,
+		})
+		counts[row.Key] = row.Count
+		total += row.Count
+	}
+
+	if err := d.Set("rows", unstructuredRows); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("counts", counts); err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("total", total)
+
+	// always run
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+
+	return diags
+}
+
+func dataSourceNoticeReport() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNoticeReportRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"report": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "One of `notices_by_class`, `notices_by_location`, `notices_by_user` or `notices_per_day`.",
+				ValidateFunc: validation.StringInSlice([]string{"notices_by_class", "notices_by_location", "notices_by_user", "notices_per_day"}, false),
+			},
+			"start_at": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ISO 8601 start of the report window. Defaults to one week ago on the API side.",
+			},
+			"stop_at": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ISO 8601 end of the report window. Defaults to now on the API side.",
+			},
+			"environment": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"total": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"counts": &schema.Schema{
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Number of notices keyed by class, location, user or day, depending on the report.",
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"rows": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"count": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
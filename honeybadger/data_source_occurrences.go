@@ -0,0 +1,114 @@
+package honeybadger
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"time"
+
+	hbc "terraform-provider-honeybadger/cli"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceOccurrencesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*hbc.HoneybadgerClient).WithContext(ctx)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	projectID := d.Get("project_id").(int)
+	period := d.Get("period").(string)
+	environment := d.Get("environment").(string)
+	occurrences, err := c.GetProjectOccurrences(projectID, period, environment)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Keep a stable order between reads
+	var environments []string
+	for env := range occurrences {
+		environments = append(environments, env)
+	}
+	sort.Strings(environments)
+
+	var unstructuredOccurrences []map[string]interface{}
+	totals := map[string]interface{}{}
+
+	for _, env := range environments {
+		total := 0
+		for _, occurrence := range occurrences[env] {
+			unstructuredOccurrences = append(unstructuredOccurrences, map[string]interface{}{
+				"environment": env,
+				"timestamp":   int(occurrence.Timestamp),
+				"count":       occurrence.Count,
+			})
+			total += occurrence.Count
+		}
+		totals[env] = total
+	}
+
+	if err := d.Set("occurrences", unstructuredOccurrences); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("totals", totals); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// always run
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+
+	return diags
+}
+
+func dataSourceOccurrences() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceOccurrencesRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"period": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "One of `hour`, `day`, `week` or `month`. Defaults to `hour` on the API side.",
+				ValidateFunc: validation.StringInSlice([]string{"hour", "day", "week", "month"}, false),
+			},
+			"environment": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"totals": &schema.Schema{
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Number of occurrences within the period, keyed by environment.",
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"occurrences": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"environment": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"timestamp": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"count": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
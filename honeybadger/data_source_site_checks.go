@@ -0,0 +1,128 @@
+package honeybadger
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	hbc "terraform-provider-honeybadger/cli"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceSiteChecksRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*hbc.HoneybadgerClient).WithContext(ctx)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	projectID := d.Get("project_id").(int)
+	siteID := d.Get("site_id").(string)
+	checks, err := c.GetSiteChecks(projectID, siteID, hbc.HoneybadgerSiteQuery{
+		CreatedAfter:  d.Get("created_after").(string),
+		CreatedBefore: d.Get("created_before").(string),
+		Limit:         d.Get("limit").(int),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var unstructuredChecks []map[string]interface{}
+	upChecks := 0
+
+	for _, check := range checks {
+		unstructuredChecks = append(unstructuredChecks, map[string]interface{}{
+			"created_at":  check.CreatedAt,
+			"location":    check.Location,
+			"up":          check.IsUp,
+			"duration":    check.Duration,
+			"status_code": check.Response,
+		})
+		if check.IsUp {
+			upChecks++
+		}
+	}
+
+	availability := 100.0
+	if len(checks) > 0 {
+		availability = float64(upChecks) * 100 / float64(len(checks))
+	}
+
+	if err := d.Set("checks", unstructuredChecks); err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("availability", availability)
+
+	// always run
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+
+	return diags
+}
+
+func dataSourceSiteChecks() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSiteChecksRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"site_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"created_after": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only checks created after this Unix timestamp.",
+			},
+			"created_before": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only checks created before this Unix timestamp.",
+			},
+			"limit": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Maximum number of checks to return. All matching checks are returned when unset.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"availability": &schema.Schema{
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Percentage of the returned checks that found the site up.",
+			},
+			"checks": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"created_at": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"location": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"up": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"duration": &schema.Schema{
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Response time in milliseconds.",
+						},
+						"status_code": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,149 @@
+package honeybadger
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	hbc "terraform-provider-honeybadger/cli"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceSiteOutagesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*hbc.HoneybadgerClient).WithContext(ctx)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	projectID := d.Get("project_id").(int)
+	siteID := d.Get("site_id").(string)
+	outages, err := c.GetSiteOutages(projectID, siteID, hbc.HoneybadgerSiteQuery{
+		CreatedAfter:  d.Get("created_after").(string),
+		CreatedBefore: d.Get("created_before").(string),
+		Limit:         d.Get("limit").(int),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var unstructuredOutages []map[string]interface{}
+	totalDuration := 0
+
+	for _, outage := range outages {
+		duration, err := outageDuration(outage)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		unstructuredOutages = append(unstructuredOutages, map[string]interface{}{
+			"down_at":     outage.DownAt,
+			"up_at":       outage.UpAt,
+			"up":          outage.UpAt != "",
+			"duration":    duration,
+			"status_code": outage.Status,
+			"reason":      outage.Reason,
+		})
+		totalDuration += duration
+	}
+
+	if err := d.Set("outages", unstructuredOutages); err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("total_duration", totalDuration)
+
+	// always run
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+
+	return diags
+}
+
+// outageDuration - Seconds the site was down, up to now for outages still in progress
+func outageDuration(outage hbc.HoneybadgerSiteOutage) (int, error) {
+	downAt, err := time.Parse(time.RFC3339, outage.DownAt)
+	if err != nil {
+		return 0, fmt.Errorf("invalid down_at of outage created at %s: %s", outage.CreatedAt, err)
+	}
+
+	upAt := time.Now()
+	if outage.UpAt != "" {
+		upAt, err = time.Parse(time.RFC3339, outage.UpAt)
+		if err != nil {
+			return 0, fmt.Errorf("invalid up_at of outage created at %s: %s", outage.CreatedAt, err)
+		}
+	}
+
+	return int(upAt.Sub(downAt).Seconds()), nil
+}
+
+func dataSourceSiteOutages() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSiteOutagesRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"site_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"created_after": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only outages created after this Unix timestamp.",
+			},
+			"created_before": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only outages created before this Unix timestamp.",
+			},
+			"limit": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Maximum number of outages to return. All matching outages are returned when unset.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"total_duration": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Seconds the site was down across all the returned outages.",
+			},
+			"outages": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"down_at": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"up_at": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"up": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"duration": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"status_code": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"reason": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,28 @@
+package honeybadger
+
+import (
+	"testing"
+
+	hbc "terraform-provider-honeybadger/cli"
+)
+
+func TestOutageDuration(t *testing.T) {
+	duration, err := outageDuration(hbc.HoneybadgerSiteOutage{DownAt: "2022-08-12T09:00:00Z", UpAt: "2022-08-12T11:01:30+02:00"})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if duration != 90 {
+		t.Fatalf("duration %d, expected 90", duration)
+	}
+
+	// Outages are never reported as zero seconds long because of a timestamp that cannot be parsed
+	invalidOutages := []hbc.HoneybadgerSiteOutage{
+		{DownAt: "yesterday", UpAt: "2022-08-12T09:00:00Z"},
+		{DownAt: "2022-08-12T09:00:00Z", UpAt: "today"},
+	}
+	for _, outage := range invalidOutages {
+		if _, err := outageDuration(outage); err == nil {
+			t.Fatalf("expected an error for the outage %v", outage)
+		}
+	}
+}
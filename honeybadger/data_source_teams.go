@@ -12,7 +12,7 @@ import (
 )
 
 func dataSourceTeamsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*hbc.HoneybadgerClient)
+	c := m.(*hbc.HoneybadgerClient).WithContext(ctx)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -0,0 +1,150 @@
+package honeybadger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"terraform-provider-honeybadger/cli"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ provider.Provider = &frameworkProvider{}
+
+// frameworkProvider - Serves the resources ported to terraform-plugin-framework. It is muxed with the
+// SDK provider, so its schema must stay identical to the one of Provider()
+type frameworkProvider struct{}
+
+type frameworkProviderModel struct {
+	Region        types.String   `tfsdk:"region"`
+	Host          types.String   `tfsdk:"host"`
+	ReportingHost types.String   `tfsdk:"reporting_host"`
+	APIKey        types.String   `tfsdk:"api_key"`
+	APIKeyFile    types.String   `tfsdk:"api_key_file"`
+	APIKeyCommand []types.String `tfsdk:"api_key_command"`
+	AccountID     types.String   `tfsdk:"account_id"`
+
+	// SkipCredentialsValidation - Only decoded, as the credentials are checked by the SDK provider
+	SkipCredentialsValidation types.Bool `tfsdk:"skip_credentials_validation"`
+}
+
+// NewFrameworkProvider -
+func NewFrameworkProvider() provider.Provider {
+	return &frameworkProvider{}
+}
+
+func (p *frameworkProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "honeybadger"
+}
+
+func (p *frameworkProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"region": schema.StringAttribute{
+				Optional:    true,
+				Description: "Honeybadger region, `us` or `eu`. It picks the data API and the reporting API hosts.",
+			},
+			"host": schema.StringAttribute{
+				Optional:    true,
+				Description: "Data API host. Overrides `region`, and is also used for the reporting API unless `reporting_host` is set.",
+			},
+			"reporting_host": schema.StringAttribute{
+				Optional:    true,
+				Description: "Reporting API host, used for deploys, check-ins and source maps. Overrides `region` and `host`.",
+			},
+			"api_key": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: apiKeyDescription,
+			},
+			"api_key_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of a file containing the API key, e.g. a secret rendered by Vault Agent.",
+			},
+			"api_key_command": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Credential helper printing the API key, as the command followed by its arguments.",
+			},
+			"account_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Account that projects and teams are listed from and created in. All the accounts the API key has access to are used when unset.",
+			},
+			"skip_credentials_validation": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Do not check `host`, `api_key` and `account_id` against the API when the provider is configured, e.g. for offline plans.",
+			},
+		},
+	}
+}
+
+func (p *frameworkProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	var config frameworkProviderModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, err := newProviderClient(ctx, providerConfig{
+		Region:        stringValueOrEnv(config.Region, "HONEYBADGER_REGION"),
+		Host:          stringValueOrEnv(config.Host, "HONEYBADGER_HOST"),
+		ReportingHost: stringValueOrEnv(config.ReportingHost, "HONEYBADGER_REPORTING_HOST"),
+		APIKey:        config.APIKey.ValueString(),
+		APIKeyFile:    config.APIKeyFile.ValueString(),
+		APIKeyCommand: stringListValue(config.APIKeyCommand),
+		AccountID:     stringValueOrEnv(config.AccountID, "HONEYBADGER_ACCOUNT_ID"),
+
+		// Both providers are configured together through the mux and the SDK provider already checks the
+		// credentials, so the accounts are only requested once per plan
+		SkipCredentialsValidation: true,
+	})
+	if err != nil {
+		var credentialsErr *cli.CredentialsError
+		if errors.As(err, &credentialsErr) {
+			resp.Diagnostics.AddAttributeError(path.Root(credentialsErr.Setting), fmt.Sprintf("Invalid Honeybadger provider setting '%s'", credentialsErr.Setting), credentialsErr.Reason)
+			return
+		}
+		resp.Diagnostics.AddError("Unable to create Honeybadger client", err.Error())
+		return
+	}
+
+	resp.DataSourceData = c
+	resp.ResourceData = c
+}
+
+func (p *frameworkProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		NewUserResource,
+		NewTeamMemberResource,
+		NewTeamMembersResource,
+	}
+}
+
+func (p *frameworkProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	return []func() datasource.DataSource{}
+}
+
+// stringValueOrEnv - Same defaulting as schema.EnvDefaultFunc in the SDK provider
+func stringValueOrEnv(value types.String, env string) string {
+	if value.IsNull() || value.IsUnknown() {
+		return os.Getenv(env)
+	}
+
+	return value.ValueString()
+}
+
+func stringListValue(values []types.String) []string {
+	var list []string
+	for _, value := range values {
+		list = append(list, value.ValueString())
+	}
+	return list
+}
@@ -0,0 +1,38 @@
+package honeybadger
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
+	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ProviderServerFactory - Serves the SDK and the framework providers as a single protocol v6 provider,
+// so resources can be moved to the framework one at a time
+func ProviderServerFactory(ctx context.Context) (func() tfprotov6.ProviderServer, error) {
+	return newProviderServerFactory(ctx, Provider())
+}
+
+func newProviderServerFactory(ctx context.Context, sdkProvider *schema.Provider) (func() tfprotov6.ProviderServer, error) {
+	upgradedSdkServer, err := tf5to6server.UpgradeServer(ctx, sdkProvider.GRPCProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	providers := []func() tfprotov6.ProviderServer{
+		providerserver.NewProtocol6(NewFrameworkProvider()),
+		func() tfprotov6.ProviderServer {
+			return upgradedSdkServer
+		},
+	}
+
+	muxServer, err := tf6muxserver.NewMuxServer(ctx, providers...)
+	if err != nil {
+		return nil, err
+	}
+
+	return muxServer.ProviderServer, nil
+}
@@ -2,58 +2,153 @@ package honeybadger
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
+
 	"terraform-provider-honeybadger/cli"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// apiKeyDescription - Shared by the SDK and the framework provider schemas, which must be identical
+const apiKeyDescription = "Personal auth token. When unset, it is read from `api_key_file`, then from the output of `api_key_command`, then from the `HONEYBADGER_API_KEY` environment variable, then from the file named by `HONEYBADGER_API_KEY_FILE`."
+
+// defaultTimeout - Create, update and delete timeout of every resource, unless set in its timeouts block
+const defaultTimeout = 5 * time.Minute
+
+// projectDeleteTimeout - Big projects are deleted in the background and take longer to be gone
+const projectDeleteTimeout = 20 * time.Minute
+
 // Provider -
 func Provider() *schema.Provider {
 	return &schema.Provider{
 		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Honeybadger region, `us` or `eu`. It picks the data API and the reporting API hosts.",
+				DefaultFunc:  schema.EnvDefaultFunc("HONEYBADGER_REGION", "us"),
+				ValidateFunc: validation.StringInSlice([]string{"us", "eu"}, false),
+			},
 			"host": &schema.Schema{
 				Type:        schema.TypeString,
 				Optional:    true,
+				Description: "Data API host. Overrides `region`, and is also used for the reporting API unless `reporting_host` is set.",
 				DefaultFunc: schema.EnvDefaultFunc("HONEYBADGER_HOST", nil),
 			},
+			"reporting_host": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Reporting API host, used for deploys, check-ins and source maps. Overrides `region` and `host`.",
+				DefaultFunc: schema.EnvDefaultFunc("HONEYBADGER_REPORTING_HOST", nil),
+			},
 			"api_key": &schema.Schema{
 				Type:        schema.TypeString,
 				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("HONEYBADGER_API_KEY", nil),
+				Sensitive:   true,
+				Description: apiKeyDescription,
+			},
+			"api_key_file": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Path of a file containing the API key, e.g. a secret rendered by Vault Agent.",
+			},
+			"api_key_command": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Credential helper printing the API key, as the command followed by its arguments.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"account_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Account that projects and teams are listed from and created in. All the accounts the API key has access to are used when unset.",
+				DefaultFunc: schema.EnvDefaultFunc("HONEYBADGER_ACCOUNT_ID", nil),
+			},
+			"skip_credentials_validation": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Do not check `host`, `api_key` and `account_id` against the API when the provider is configured, e.g. for offline plans.",
+				DefaultFunc: schema.EnvDefaultFunc("HONEYBADGER_SKIP_CREDENTIALS_VALIDATION", false),
 			},
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"honeybadger_teams": dataSourceTeams(),
+			"honeybadger_teams":                dataSourceTeams(),
+			"honeybadger_accounts":             dataSourceAccounts(),
+			"honeybadger_faults":               dataSourceFaults(),
+			"honeybadger_deploys":              dataSourceDeploys(),
+			"honeybadger_occurrences":          dataSourceOccurrences(),
+			"honeybadger_notice_report":        dataSourceNoticeReport(),
+			"honeybadger_site_outages":         dataSourceSiteOutages(),
+			"honeybadger_site_checks":          dataSourceSiteChecks(),
+			"honeybadger_fault_comments":       dataSourceFaultComments(),
+			"honeybadger_fault_notices":        dataSourceFaultNotices(),
+			"honeybadger_fault_affected_users": dataSourceFaultAffectedUsers(),
+			"honeybadger_insights_query":       dataSourceInsightsQuery(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"honeybadger_user":    resourceUser(),
-			"honeybadger_team":    resourceTeam(),
-			"honeybadger_project": resourceProject(),
+			"honeybadger_team":          resourceTeam(),
+			"honeybadger_project":       resourceProject(),
+			"honeybadger_account_user":  resourceAccountUser(),
+			"honeybadger_fault":         resourceFault(),
+			"honeybadger_deploy":        resourceDeploy(),
+			"honeybadger_source_map":    resourceSourceMap(),
+			"honeybadger_fault_comment": resourceFaultComment(),
+			"honeybadger_dashboard":     resourceDashboard(),
+			"honeybadger_alarm":         resourceAlarm(),
+			"honeybadger_status_page":   resourceStatusPage(),
+			"honeybadger_check_in_ping": resourceCheckInPing(),
 		},
 		ConfigureContextFunc: providerConfigure,
 	}
 }
 
 func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
-	host := d.Get("host").(string)
-	authToken := d.Get("api_key").(string)
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
-	if authToken != "" {
-		c := cli.NewClient(&host, &authToken)
-
-		return c, diags
-	}
+	c, err := newProviderClient(ctx, providerConfig{
+		Region:        d.Get("region").(string),
+		Host:          d.Get("host").(string),
+		ReportingHost: d.Get("reporting_host").(string),
+		APIKey:        d.Get("api_key").(string),
+		APIKeyFile:    d.Get("api_key_file").(string),
+		APIKeyCommand: expandStringList(d.Get("api_key_command").([]interface{})),
+		AccountID:     d.Get("account_id").(string),
 
-	if authToken == "" {
-		diags = append(diags, diag.Diagnostic{
+		SkipCredentialsValidation: d.Get("skip_credentials_validation").(bool),
+	})
+	if err != nil {
+		diagnostic := diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Unable to create Honeybadger client",
-			Detail:   "Honeybadger Client cannot be created because 'api_key' provider parameter is not defined",
-		})
+			Detail:   err.Error(),
+		}
+
+		var credentialsErr *cli.CredentialsError
+		if errors.As(err, &credentialsErr) {
+			diagnostic.Summary = fmt.Sprintf("Invalid Honeybadger provider setting '%s'", credentialsErr.Setting)
+			diagnostic.Detail = credentialsErr.Reason
+			diagnostic.AttributePath = cty.GetAttrPath(credentialsErr.Setting)
+		}
+		diags = append(diags, diagnostic)
+
+		return nil, diags
 	}
 
-	return nil, diags
+	return c, diags
+}
+
+func expandStringList(items []interface{}) []string {
+	var list []string
+	for _, item := range items {
+		list = append(list, item.(string))
+	}
+	return list
 }
@@ -1,19 +1,30 @@
 package honeybadger
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-var testAccProviders map[string]*schema.Provider
+var testAccProtoV6ProviderFactories map[string]func() (tfprotov6.ProviderServer, error)
 var testAccProvider *schema.Provider
 
 func init() {
 	testAccProvider = Provider()
-	testAccProviders = map[string]*schema.Provider{
-		"honeybadger": testAccProvider,
+	testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+		"honeybadger": func() (tfprotov6.ProviderServer, error) {
+			providerServer, err := newProviderServerFactory(context.Background(), testAccProvider)
+			if err != nil {
+				return nil, err
+			}
+			return providerServer(), nil
+		},
 	}
 }
 
@@ -27,6 +38,102 @@ func TestProvider_impl(t *testing.T) {
 	var _ *schema.Provider = Provider()
 }
 
+func TestProviderServer(t *testing.T) {
+	ctx := context.Background()
+
+	providerServer, err := ProviderServerFactory(ctx)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// The mux server rejects SDK and framework provider schemas that differ
+	resp, err := providerServer().GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			t.Fatalf("err: %s: %s", d.Summary, d.Detail)
+		}
+	}
+	if _, ok := resp.ResourceSchemas["honeybadger_user"]; !ok {
+		t.Fatal("honeybadger_user must be served by the framework provider")
+	}
+	if _, ok := resp.ResourceSchemas["honeybadger_team"]; !ok {
+		t.Fatal("honeybadger_team must be served by the SDK provider")
+	}
+}
+
+func TestProviderServerValidatesCredentialsOnce(t *testing.T) {
+	ctx := context.Background()
+	t.Setenv("HONEYBADGER_SKIP_CREDENTIALS_VALIDATION", "")
+
+	accountsRequests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		accountsRequests++
+		if r.Header.Get("Authorization") != "Basic dmFsaWQ6" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(`{"results":[{"id":"abc","name":"Sequra"}]}`))
+	}))
+	defer server.Close()
+
+	expectedConfigurations := []struct {
+		apiKey  string
+		summary string
+	}{
+		{apiKey: "valid"},
+		{apiKey: "wrong", summary: "Invalid Honeybadger provider setting 'api_key'"},
+	}
+
+	for _, expected := range expectedConfigurations {
+		accountsRequests = 0
+
+		providerServer, err := newProviderServerFactory(ctx, Provider())
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		muxServer := providerServer()
+
+		schemaResp, err := muxServer.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		configType := schemaResp.Provider.ValueType()
+
+		configValues := map[string]tftypes.Value{}
+		for name, attributeType := range configType.(tftypes.Object).AttributeTypes {
+			configValues[name] = tftypes.NewValue(attributeType, nil)
+		}
+		configValues["host"] = tftypes.NewValue(tftypes.String, server.URL)
+		configValues["api_key"] = tftypes.NewValue(tftypes.String, expected.apiKey)
+
+		config, err := tfprotov6.NewDynamicValue(configType, tftypes.NewValue(configType, configValues))
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		resp, err := muxServer.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{Config: &config})
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		var summaries []string
+		for _, d := range resp.Diagnostics {
+			if d.Severity == tfprotov6.DiagnosticSeverityError {
+				summaries = append(summaries, d.Summary)
+			}
+		}
+		if (expected.summary == "" && len(summaries) != 0) || (expected.summary != "" && (len(summaries) != 1 || summaries[0] != expected.summary)) {
+			t.Fatalf("api_key %s: unexpected errors %v", expected.apiKey, summaries)
+		}
+		if accountsRequests != 1 {
+			t.Fatalf("api_key %s: expected credentials to be validated once, got %d requests", expected.apiKey, accountsRequests)
+		}
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 	if err := os.Getenv("HONEYBADGER_HOST"); err == "" {
 		t.Fatal("HONEYBADGER_HOST must be set for acceptance tests")
@@ -0,0 +1,181 @@
+package honeybadger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	hbc "terraform-provider-honeybadger/cli"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAccountUser() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAccountUserCreate,
+		ReadContext:   resourceAccountUserRead,
+		UpdateContext: resourceAccountUserUpdate,
+		DeleteContext: resourceAccountUserDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+		Schema: map[string]*schema.Schema{
+			"last_updated": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"account_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"email": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateEmail,
+			},
+			"role": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "member",
+				ValidateFunc: validation.StringInSlice([]string{"owner", "admin", "member"}, false),
+			},
+			"user_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"pending": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAccountUserImport,
+		},
+	}
+}
+
+func resourceAccountUserCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*hbc.HoneybadgerClient).WithContext(ctx)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	accountID := d.Get("account_id").(string)
+	userEmail := d.Get("email").(string)
+	role := d.Get("role").(string)
+	_, err := c.CreateAccountInvitation(accountID, userEmail, role)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("User %s will be invited to account %s with role %s", userEmail, accountID, role)
+
+	d.SetId(accountID + "/" + userEmail)
+	d.Set("last_updated", time.Now().Format(time.RFC850))
+
+	resourceAccountUserRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceAccountUserUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*hbc.HoneybadgerClient).WithContext(ctx)
+
+	if d.HasChange("role") {
+		accountID := d.Get("account_id").(string)
+		userEmail := d.Get("email").(string)
+		role := d.Get("role").(string)
+		user, err := c.GetAccountUserByEmail(accountID, userEmail)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if user.IsPending {
+			err = c.UpdateAccountInvitation(accountID, user.ID, role)
+		} else {
+			err = c.UpdateAccountUser(accountID, user.ID, role)
+		}
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		d.Set("last_updated", time.Now().Format(time.RFC850))
+	}
+
+	return resourceAccountUserRead(ctx, d, m)
+}
+
+func resourceAccountUserDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*hbc.HoneybadgerClient).WithContext(ctx)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	accountID := d.Get("account_id").(string)
+	userEmail := d.Get("email").(string)
+	user, err := c.GetAccountUserByEmail(accountID, userEmail)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if user.IsPending {
+		err = c.DeleteAccountInvitation(accountID, user.ID)
+	} else {
+		err = c.DeleteAccountUser(accountID, user.ID)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("User %s will be removed from account %s", userEmail, accountID)
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceAccountUserRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*hbc.HoneybadgerClient).WithContext(ctx)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	accountID := d.Get("account_id").(string)
+	userEmail := d.Get("email").(string)
+	user, err := c.GetAccountUserByEmail(accountID, userEmail)
+	if errors.Is(err, hbc.ErrAccountUserNotFound) {
+		log.Printf("User %s not found in account %s, removing it from state", userEmail, accountID)
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("role", strings.ToLower(user.Role))
+	d.Set("user_id", user.ID)
+	d.Set("pending", user.IsPending)
+
+	return diags
+}
+
+func resourceAccountUserImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected format of ID (%s), expected account_id/email", d.Id())
+	}
+
+	d.Set("account_id", parts[0])
+	d.Set("email", parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,77 @@
+package honeybadger
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	hbc "terraform-provider-honeybadger/cli"
+)
+
+func TestAccHoneybadgerAccountUserBasic(t *testing.T) {
+	accountID := "abcdef"
+	email := "test.sequra@sequra.es"
+	role := "member"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckHoneybadgerAccountUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckHoneybadgerAccountUserConfigBasic(accountID, email, role),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHoneybadgerAccountUserExists("honeybadger_account_user.test"),
+				),
+			},
+		},
+	})
+}
+func testAccCheckHoneybadgerAccountUserConfigBasic(accountID string, email string, role string) string {
+	return fmt.Sprintf(`
+	resource "honeybadger_account_user" "test" {
+		account_id = "%s"
+		email      = "%s"
+		role       = "%s"
+	}
+	`, accountID, email, role)
+}
+
+func testAccCheckHoneybadgerAccountUserDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*hbc.HoneybadgerClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "honeybadger_account_user" {
+			continue
+		}
+
+		accountID := rs.Primary.Attributes["account_id"]
+		email := rs.Primary.Attributes["email"]
+
+		_, err := c.GetAccountUserByEmail(accountID, email)
+		if !errors.Is(err, hbc.ErrAccountUserNotFound) {
+			return fmt.Errorf("User %s still belongs to account %s", email, accountID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckHoneybadgerAccountUserExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No AccountUser ID set")
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,242 @@
+package honeybadger
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	hbc "terraform-provider-honeybadger/cli"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAlarm() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAlarmCreate,
+		ReadContext:   resourceAlarmRead,
+		UpdateContext: resourceAlarmUpdate,
+		DeleteContext: resourceAlarmDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+		Schema: map[string]*schema.Schema{
+			"last_updated": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"project_id": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"query": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "BadgerQL query. The alarm triggers on the number of results it returns.",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"trigger": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"comparison": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "One of `gt`, `gte`, `lt`, `lte` or `eq`.",
+							ValidateFunc: validation.StringInSlice([]string{"gt", "gte", "lt", "lte", "eq"}, false),
+						},
+						"threshold": &schema.Schema{
+							Type:     schema.TypeFloat,
+							Required: true,
+						},
+					},
+				},
+			},
+			"evaluation_period": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "5m",
+				Description:  "How often the query is evaluated, and the time window it covers, e.g. `5m` or `1h`.",
+				ValidateFunc: validateAlarmDuration,
+			},
+			"lookback": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "0m",
+				Description:  "How far back the evaluation window ends, to allow for late events, e.g. `1m`.",
+				ValidateFunc: validateAlarmDuration,
+			},
+			"integration_ids": &schema.Schema{
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "IDs of the project integrations to notify when the alarm triggers.",
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"state": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAlarmImport,
+		},
+	}
+}
+
+func resourceAlarmCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*hbc.HoneybadgerClient).WithContext(ctx)
+
+	projectID := d.Get("project_id").(int)
+	hbAlarm, err := c.CreateAlarm(projectID, expandAlarm(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(hbAlarm.ID))
+	d.Set("last_updated", time.Now().Format(time.RFC850))
+
+	return resourceAlarmRead(ctx, d, m)
+}
+
+func resourceAlarmUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*hbc.HoneybadgerClient).WithContext(ctx)
+
+	if d.HasChanges("name", "description", "query", "trigger", "evaluation_period", "lookback", "integration_ids") {
+		projectID := d.Get("project_id").(int)
+		alarmID, _ := strconv.Atoi(d.Id())
+		err := c.UpdateAlarm(projectID, alarmID, expandAlarm(d))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		d.Set("last_updated", time.Now().Format(time.RFC850))
+	}
+
+	return resourceAlarmRead(ctx, d, m)
+}
+
+func resourceAlarmDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*hbc.HoneybadgerClient).WithContext(ctx)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	projectID := d.Get("project_id").(int)
+	alarmID, _ := strconv.Atoi(d.Id())
+	err := c.DeleteAlarm(projectID, alarmID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceAlarmRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*hbc.HoneybadgerClient).WithContext(ctx)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	projectID := d.Get("project_id").(int)
+	alarmID, _ := strconv.Atoi(d.Id())
+	alarm, err := c.GetAlarm(projectID, alarmID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("name", alarm.Name)
+	d.Set("description", alarm.Description)
+	d.Set("query", alarm.Query)
+	d.Set("evaluation_period", alarm.EvaluationPeriod)
+	d.Set("lookback", alarm.LookbackLag)
+	d.Set("state", alarm.State)
+	if err := d.Set("trigger", []map[string]interface{}{
+		{
+			"comparison": alarm.TriggerConfig.Operator,
+			"threshold":  alarm.TriggerConfig.Value,
+		},
+	}); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("integration_ids", alarm.IntegrationIDs); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceAlarmImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("unexpected format of ID (%s), expected project_id/alarm_id", d.Id())
+	}
+
+	projectID, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid project_id in ID (%s): %s", d.Id(), err)
+	}
+
+	d.Set("project_id", projectID)
+	d.SetId(parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandAlarm(d *schema.ResourceData) hbc.HoneybadgerAlarm {
+	trigger := d.Get("trigger").([]interface{})[0].(map[string]interface{})
+
+	integrationIDs := []int{}
+	for _, integrationID := range d.Get("integration_ids").(*schema.Set).List() {
+		integrationIDs = append(integrationIDs, integrationID.(int))
+	}
+
+	return hbc.HoneybadgerAlarm{
+		Name:             d.Get("name").(string),
+		Description:      d.Get("description").(string),
+		Query:            d.Get("query").(string),
+		EvaluationPeriod: d.Get("evaluation_period").(string),
+		LookbackLag:      d.Get("lookback").(string),
+		TriggerConfig: hbc.HoneybadgerAlarmTrigger{
+			Type:     "result_count",
+			Operator: trigger["comparison"].(string),
+			Value:    trigger["threshold"].(float64),
+		},
+		IntegrationIDs: integrationIDs,
+	}
+}
+
+// validateAlarmDuration - Alarm windows are whole minutes, hours or days, e.g. 5m, 1h or 1d
+func validateAlarmDuration(i interface{}, k string) ([]string, []error) {
+	v := i.(string)
+	if len(v) < 2 || !strings.ContainsAny(v[len(v)-1:], "mhd") {
+		return nil, []error{fmt.Errorf("expected %s to be a number of minutes, hours or days such as 5m, got %q", k, v)}
+	}
+	if _, err := strconv.Atoi(v[:len(v)-1]); err != nil {
+		return nil, []error{fmt.Errorf("expected %s to be a number of minutes, hours or days such as 5m, got %q", k, v)}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,86 @@
+package honeybadger
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	hbc "terraform-provider-honeybadger/cli"
+)
+
+func TestAccHoneybadgerAlarmBasic(t *testing.T) {
+	projectID := 1234
+	name := "Checkout errors"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckHoneybadgerAlarmDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckHoneybadgerAlarmConfigBasic(projectID, name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHoneybadgerAlarmExists("honeybadger_alarm.test"),
+					resource.TestCheckResourceAttr("honeybadger_alarm.test", "trigger.0.comparison", "gt"),
+					resource.TestCheckResourceAttr("honeybadger_alarm.test", "evaluation_period", "5m"),
+				),
+			},
+		},
+	})
+}
+func testAccCheckHoneybadgerAlarmConfigBasic(projectID int, name string) string {
+	return fmt.Sprintf(`
+	resource "honeybadger_alarm" "test" {
+		project_id = %d
+		name       = "%s"
+		query      = "filter request.path == '/checkout' | stats count()"
+
+		trigger {
+			comparison = "gt"
+			threshold  = 10
+		}
+	}
+	`, projectID, name)
+}
+
+func testAccCheckHoneybadgerAlarmDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*hbc.HoneybadgerClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "honeybadger_alarm" {
+			continue
+		}
+
+		projectID, _ := strconv.Atoi(rs.Primary.Attributes["project_id"])
+		alarmID, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = c.GetAlarm(projectID, alarmID)
+		if err == nil {
+			return fmt.Errorf("Alarm %d still exists", alarmID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckHoneybadgerAlarmExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No AlarmID set")
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,96 @@
+package honeybadger
+
+import (
+	"context"
+	"log"
+	"time"
+
+	hbc "terraform-provider-honeybadger/cli"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCheckInPing() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCheckInPingCreate,
+		ReadContext:   resourceCheckInPingRead,
+		DeleteContext: resourceCheckInPingDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+		Schema: map[string]*schema.Schema{
+			"check_in_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "ID of the check-in, the last segment of its reporting URL.",
+				ExactlyOneOf: []string{"check_in_id", "url"},
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"url": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "Full reporting URL of the check-in.",
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"triggers": &schema.Schema{
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary values that ping the check-in again when they change.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"pinged_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCheckInPingCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*hbc.HoneybadgerClient).WithContext(ctx)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	pingURL := d.Get("url").(string)
+	if pingURL == "" {
+		pingURL = c.CheckInPingURL(d.Get("check_in_id").(string))
+	}
+
+	err := c.PingCheckIn(pingURL)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(pingURL)
+	d.Set("pinged_at", time.Now().Format(time.RFC850))
+
+	return diags
+}
+
+func resourceCheckInPingRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	// A ping is a one-off event, there is nothing to refresh
+	return diags
+}
+
+func resourceCheckInPingDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	// Pings cannot be undone, the resource only stops tracking them
+	log.Printf("Check-in ping %s is no longer managed by Terraform", d.Id())
+	d.SetId("")
+
+	return diags
+}
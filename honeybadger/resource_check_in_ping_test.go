@@ -0,0 +1,50 @@
+package honeybadger
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccHoneybadgerCheckInPingBasic(t *testing.T) {
+	checkInID := "abc123"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckHoneybadgerCheckInPingConfigBasic(checkInID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHoneybadgerCheckInPingExists("honeybadger_check_in_ping.test"),
+					resource.TestCheckResourceAttrSet("honeybadger_check_in_ping.test", "pinged_at"),
+				),
+			},
+		},
+	})
+}
+func testAccCheckHoneybadgerCheckInPingConfigBasic(checkInID string) string {
+	return fmt.Sprintf(`
+	resource "honeybadger_check_in_ping" "test" {
+		check_in_id = "%s"
+	}
+	`, checkInID)
+}
+
+func testAccCheckHoneybadgerCheckInPingExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CheckInPing ID set")
+		}
+
+		return nil
+	}
+}
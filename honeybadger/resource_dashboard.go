@@ -0,0 +1,273 @@
+package honeybadger
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	hbc "terraform-provider-honeybadger/cli"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// dashboardColumns - Width of the Insights dashboard grid
+const dashboardColumns = 12
+
+func resourceDashboard() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDashboardCreate,
+		ReadContext:   resourceDashboardRead,
+		UpdateContext: resourceDashboardUpdate,
+		DeleteContext: resourceDashboardDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+		Schema: map[string]*schema.Schema{
+			"last_updated": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"project_id": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"title": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"widget": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Widgets are laid out in order, left to right and top to bottom.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"title": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"query": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "BadgerQL query.",
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"visualization": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "line",
+							ValidateFunc: validation.StringInSlice([]string{"line", "bar", "area", "scatter", "table", "number", "pie"}, false),
+						},
+						"ts": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "PT3H",
+							Description: "ISO 8601 duration of the time range, ending now.",
+						},
+						"width": &schema.Schema{
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      6,
+							Description:  "Width in grid columns, out of 12.",
+							ValidateFunc: validation.IntBetween(1, dashboardColumns),
+						},
+						"height": &schema.Schema{
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      4,
+							Description:  "Height in grid rows.",
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDashboardImport,
+		},
+	}
+}
+
+func resourceDashboardCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*hbc.HoneybadgerClient).WithContext(ctx)
+
+	projectID := d.Get("project_id").(int)
+	hbDashboard, err := c.CreateDashboard(projectID, expandDashboard(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(hbDashboard.ID))
+	d.Set("last_updated", time.Now().Format(time.RFC850))
+
+	return resourceDashboardRead(ctx, d, m)
+}
+
+func resourceDashboardUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*hbc.HoneybadgerClient).WithContext(ctx)
+
+	if d.HasChanges("title", "widget") {
+		projectID := d.Get("project_id").(int)
+		dashboardID, _ := strconv.Atoi(d.Id())
+		err := c.UpdateDashboard(projectID, dashboardID, expandDashboard(d))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		d.Set("last_updated", time.Now().Format(time.RFC850))
+	}
+
+	return resourceDashboardRead(ctx, d, m)
+}
+
+func resourceDashboardDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*hbc.HoneybadgerClient).WithContext(ctx)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	projectID := d.Get("project_id").(int)
+	dashboardID, _ := strconv.Atoi(d.Id())
+	err := c.DeleteDashboard(projectID, dashboardID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceDashboardRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*hbc.HoneybadgerClient).WithContext(ctx)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	projectID := d.Get("project_id").(int)
+	dashboardID, _ := strconv.Atoi(d.Id())
+	dashboard, err := c.GetDashboard(projectID, dashboardID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var unstructuredWidgets []map[string]interface{}
+	for _, widget := range dashboard.Widgets {
+		unstructuredWidgets = append(unstructuredWidgets, map[string]interface{}{
+			"id":            widget.ID,
+			"title":         widget.Title,
+			"query":         widget.Config.Query,
+			"visualization": widget.Config.Vis.View,
+			"ts":            widget.Config.Ts,
+			"width":         widget.Grid.W,
+			"height":        widget.Grid.H,
+		})
+	}
+
+	d.Set("title", dashboard.Title)
+	if err := d.Set("widget", unstructuredWidgets); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceDashboardImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("unexpected format of ID (%s), expected project_id/dashboard_id", d.Id())
+	}
+
+	projectID, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid project_id in ID (%s): %s", d.Id(), err)
+	}
+
+	d.Set("project_id", projectID)
+	d.SetId(parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// expandDashboard - Widgets keep the ID of the widget with the same title and query in the state, see
+// dashboardWidgetIDs. The others are sent without ID and created again
+func expandDashboard(d *schema.ResourceData) hbc.HoneybadgerDashboard {
+	dashboard := hbc.HoneybadgerDashboard{
+		Title:   d.Get("title").(string),
+		Widgets: []hbc.HoneybadgerWidget{},
+	}
+
+	previousWidgets, _ := d.GetChange("widget")
+	widgetIDs := dashboardWidgetIDs(previousWidgets.([]interface{}))
+
+	x, y, rowHeight := 0, 0, 0
+	for _, item := range d.Get("widget").([]interface{}) {
+		widget := item.(map[string]interface{})
+		width := widget["width"].(int)
+		height := widget["height"].(int)
+
+		// Move to the next row when the widget does not fit in the current one
+		if x+width > dashboardColumns {
+			x, y, rowHeight = 0, y+rowHeight, 0
+		}
+
+		// widget["id"] is not used: the list is diffed by index, so the plan carries the id of
+		// whichever widget was at this position before, wrong as soon as one is inserted or removed
+		key := dashboardWidgetKey(widget)
+		widgetID := ""
+		if ids := widgetIDs[key]; len(ids) > 0 {
+			widgetID, widgetIDs[key] = ids[0], ids[1:]
+		}
+
+		dashboard.Widgets = append(dashboard.Widgets, hbc.HoneybadgerWidget{
+			ID:    widgetID,
+			Type:  "insights_vis",
+			Title: widget["title"].(string),
+			Config: hbc.HoneybadgerWidgetConfig{
+				Query: widget["query"].(string),
+				Ts:    widget["ts"].(string),
+				Vis:   hbc.HoneybadgerWidgetVis{View: widget["visualization"].(string)},
+			},
+			Grid: hbc.HoneybadgerWidgetGrid{X: x, Y: y, W: width, H: height},
+		})
+
+		x += width
+		if height > rowHeight {
+			rowHeight = height
+		}
+	}
+
+	return dashboard
+}
+
+// dashboardWidgetIDs - IDs of the widgets in the state by title and query, in order, so widgets with
+// the same title and query keep their IDs in the order they are declared
+func dashboardWidgetIDs(widgets []interface{}) map[string][]string {
+	widgetIDs := map[string][]string{}
+	for _, item := range widgets {
+		widget := item.(map[string]interface{})
+		if widget["id"].(string) == "" {
+			continue
+		}
+		key := dashboardWidgetKey(widget)
+		widgetIDs[key] = append(widgetIDs[key], widget["id"].(string))
+	}
+	return widgetIDs
+}
+
+func dashboardWidgetKey(widget map[string]interface{}) string {
+	return widget["title"].(string) + "\x00" + widget["query"].(string)
+}
@@ -0,0 +1,132 @@
+package honeybadger
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	hbc "terraform-provider-honeybadger/cli"
+)
+
+func TestAccHoneybadgerDashboardBasic(t *testing.T) {
+	projectID := 1234
+	title := "Checkout service"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckHoneybadgerDashboardDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckHoneybadgerDashboardConfigBasic(projectID, title),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHoneybadgerDashboardExists("honeybadger_dashboard.test"),
+					resource.TestCheckResourceAttr("honeybadger_dashboard.test", "widget.#", "2"),
+					resource.TestCheckResourceAttr("honeybadger_dashboard.test", "widget.1.visualization", "number"),
+				),
+			},
+		},
+	})
+}
+func TestExpandDashboardWidgetIDs(t *testing.T) {
+	widget := func(id string, title string, query string) map[string]interface{} {
+		return map[string]interface{}{
+			"id":            id,
+			"title":         title,
+			"query":         query,
+			"visualization": "line",
+			"ts":            "PT3H",
+			"width":         6,
+			"height":        4,
+		}
+	}
+
+	previous := resourceDashboard().TestResourceData()
+	previous.SetId("42")
+	previous.Set("title", "Checkout service")
+	previous.Set("widget", []interface{}{
+		widget("a", "Errors", "fields @ts | stats count()"),
+		widget("b", "Latency", "stats avg(duration)"),
+	})
+
+	// A widget inserted first, so every other widget moves one position
+	d := resourceDashboard().Data(previous.State())
+	d.Set("widget", []interface{}{
+		widget("a", "Deploys", "filter event_type::str == \"deploy\""),
+		widget("b", "Errors", "fields @ts | stats count()"),
+		widget("", "Latency", "stats avg(duration)"),
+	})
+
+	var actualIDs []string
+	for _, widget := range expandDashboard(d).Widgets {
+		actualIDs = append(actualIDs, widget.ID)
+	}
+
+	expectedIDs := []string{"", "a", "b"}
+	if fmt.Sprint(actualIDs) != fmt.Sprint(expectedIDs) {
+		t.Fatalf("widget IDs %v, expected %v", actualIDs, expectedIDs)
+	}
+}
+
+func testAccCheckHoneybadgerDashboardConfigBasic(projectID int, title string) string {
+	return fmt.Sprintf(`
+	resource "honeybadger_dashboard" "test" {
+		project_id = %d
+		title      = "%s"
+
+		widget {
+			title = "Errors per hour"
+			query = "stats count() by bin(1h)"
+		}
+
+		widget {
+			title         = "Errors"
+			query         = "stats count()"
+			visualization = "number"
+			width         = 3
+		}
+	}
+	`, projectID, title)
+}
+
+func testAccCheckHoneybadgerDashboardDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*hbc.HoneybadgerClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "honeybadger_dashboard" {
+			continue
+		}
+
+		projectID, _ := strconv.Atoi(rs.Primary.Attributes["project_id"])
+		dashboardID, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = c.GetDashboard(projectID, dashboardID)
+		if err == nil {
+			return fmt.Errorf("Dashboard %d still exists", dashboardID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckHoneybadgerDashboardExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DashboardID set")
+		}
+
+		return nil
+	}
+}
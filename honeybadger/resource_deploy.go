@@ -0,0 +1,97 @@
+package honeybadger
+
+import (
+	"context"
+	"log"
+
+	hbc "terraform-provider-honeybadger/cli"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceDeploy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDeployCreate,
+		ReadContext:   resourceDeployRead,
+		DeleteContext: resourceDeployDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+		Schema: map[string]*schema.Schema{
+			"api_key": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				Description:  "API key of the deployed project, e.g. `honeybadger_project.app.api_key`.",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"environment": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"revision": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"repository": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"local_username": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceDeployCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*hbc.HoneybadgerClient).WithContext(ctx)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	deploy := hbc.HoneybadgerDeploy{
+		Environment:   d.Get("environment").(string),
+		Revision:      d.Get("revision").(string),
+		Repository:    d.Get("repository").(string),
+		LocalUsername: d.Get("local_username").(string),
+	}
+	err := c.CreateDeploy(d.Get("api_key").(string), deploy)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("Deploy of revision %s to %s has been recorded", deploy.Revision, deploy.Environment)
+
+	d.SetId(deploy.Revision)
+
+	return diags
+}
+
+func resourceDeployRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	// The reporting API is write only, the recorded deploy is kept as it is in the state
+	return diags
+}
+
+func resourceDeployDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	// Deploys are part of the project history, destroying the resource only forgets it
+	d.SetId("")
+
+	return diags
+}
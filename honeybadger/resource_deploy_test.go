@@ -0,0 +1,53 @@
+package honeybadger
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccHoneybadgerDeployBasic(t *testing.T) {
+	apiKey := "hbp_123"
+	revision := "b6826b8"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckHoneybadgerDeployConfigBasic(apiKey, revision),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHoneybadgerDeployExists("honeybadger_deploy.test"),
+					resource.TestCheckResourceAttr("honeybadger_deploy.test", "id", revision),
+				),
+			},
+		},
+	})
+}
+func testAccCheckHoneybadgerDeployConfigBasic(apiKey string, revision string) string {
+	return fmt.Sprintf(`
+	resource "honeybadger_deploy" "test" {
+		api_key     = "%s"
+		environment = "production"
+		revision    = "%s"
+	}
+	`, apiKey, revision)
+}
+
+func testAccCheckHoneybadgerDeployExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No revision set")
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,243 @@
+package honeybadger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	hbc "terraform-provider-honeybadger/cli"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceFault() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceFaultCreate,
+		ReadContext:   resourceFaultRead,
+		UpdateContext: resourceFaultUpdate,
+		DeleteContext: resourceFaultDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+		Schema: map[string]*schema.Schema{
+			"last_updated": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"project_id": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"fault_id": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"resolved": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the fault is resolved. It is left as it is when unset.",
+			},
+			"ignored": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the fault is ignored. It is left as it is when unset.",
+			},
+			"assignee_email": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Email of a team member the fault is assigned to. The fault is unassigned when empty, and left as it is when unset.",
+				ValidateFunc: validation.Any(validation.StringIsEmpty, validateEmail),
+			},
+			"class": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"message": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"environment": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"notices_count": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"url": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceFaultImport,
+		},
+	}
+}
+
+func resourceFaultCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*hbc.HoneybadgerClient).WithContext(ctx)
+
+	projectID := d.Get("project_id").(int)
+	faultID := d.Get("fault_id").(int)
+
+	// Faults cannot be created through the API, they can only be adopted
+	_, err := c.GetFault(projectID, faultID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = updateFault(ctx, projectID, faultID, d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(projectID) + "/" + strconv.Itoa(faultID))
+	d.Set("last_updated", time.Now().Format(time.RFC850))
+
+	return resourceFaultRead(ctx, d, m)
+}
+
+func resourceFaultUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	projectID := d.Get("project_id").(int)
+	faultID := d.Get("fault_id").(int)
+
+	if d.HasChanges("resolved", "ignored", "assignee_email") {
+		err := updateFault(ctx, projectID, faultID, d, m)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		d.Set("last_updated", time.Now().Format(time.RFC850))
+	}
+
+	return resourceFaultRead(ctx, d, m)
+}
+
+func resourceFaultDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	// Faults are never deleted, the resource only stops managing them
+	log.Printf("Fault %s is no longer managed by Terraform", d.Id())
+	d.SetId("")
+
+	return diags
+}
+
+func resourceFaultRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*hbc.HoneybadgerClient).WithContext(ctx)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	projectID := d.Get("project_id").(int)
+	faultID := d.Get("fault_id").(int)
+	fault, err := c.GetFault(projectID, faultID)
+	if errors.Is(err, hbc.ErrFaultNotFound) {
+		log.Printf("Fault %s does not exist anymore, removing it from the state", d.Id())
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	assigneeEmail := ""
+	if fault.Assignee != nil {
+		assigneeEmail = fault.Assignee.Email
+	}
+
+	d.Set("resolved", fault.IsResolved)
+	d.Set("ignored", fault.IsIgnored)
+	d.Set("assignee_email", assigneeEmail)
+	d.Set("class", fault.Klass)
+	d.Set("message", fault.Message)
+	d.Set("environment", fault.Environment)
+	d.Set("notices_count", fault.NoticesCount)
+	d.Set("url", fault.URL)
+
+	return diags
+}
+
+func resourceFaultImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("unexpected format of ID (%s), expected project_id/fault_id", d.Id())
+	}
+
+	projectID, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid project_id in ID (%s): %s", d.Id(), err)
+	}
+	faultID, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid fault_id in ID (%s): %s", d.Id(), err)
+	}
+
+	d.Set("project_id", projectID)
+	d.Set("fault_id", faultID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// updateFault - Only the attributes set in the configuration are sent, so the ones managed in the
+// Honeybadger UI are left as they are
+func updateFault(ctx context.Context, projectID int, faultID int, d *schema.ResourceData, m interface{}) error {
+	c := m.(*hbc.HoneybadgerClient).WithContext(ctx)
+
+	var update hbc.HoneybadgerFaultUpdate
+	if faultAttributeIsSet(d, "resolved") {
+		isResolved := d.Get("resolved").(bool)
+		update.IsResolved = &isResolved
+	}
+	if faultAttributeIsSet(d, "ignored") {
+		isIgnored := d.Get("ignored").(bool)
+		update.IsIgnored = &isIgnored
+	}
+
+	assigneeEmail := d.Get("assignee_email").(string)
+	if faultAttributeIsSet(d, "assignee_email") {
+		assigneeID := 0
+		if assigneeEmail != "" {
+			assignee, err := c.FindTeamMemberByEmail(assigneeEmail)
+			if err != nil {
+				return err
+			}
+			assigneeID = assignee.ID
+		}
+		update.AssigneeID = &assigneeID
+	}
+
+	if update.IsResolved == nil && update.IsIgnored == nil && update.AssigneeID == nil {
+		return nil
+	}
+
+	log.Printf("Fault %d will be updated with resolved %t, ignored %t and assignee %q", faultID, d.Get("resolved").(bool), d.Get("ignored").(bool), assigneeEmail)
+	return c.UpdateFault(projectID, faultID, update)
+}
+
+// faultAttributeIsSet - On create the attribute must be in the configuration, afterwards it must have changed
+func faultAttributeIsSet(d *schema.ResourceData, key string) bool {
+	if d.IsNewResource() {
+		return !d.GetRawConfig().GetAttr(key).IsNull()
+	}
+
+	return d.HasChange(key)
+}
@@ -0,0 +1,174 @@
+package honeybadger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	hbc "terraform-provider-honeybadger/cli"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceFaultComment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceFaultCommentCreate,
+		ReadContext:   resourceFaultCommentRead,
+		UpdateContext: resourceFaultCommentUpdate,
+		DeleteContext: resourceFaultCommentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+		Schema: map[string]*schema.Schema{
+			"last_updated": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"project_id": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"fault_id": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"body": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"comment_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"author": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceFaultCommentImport,
+		},
+	}
+}
+
+func resourceFaultCommentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*hbc.HoneybadgerClient).WithContext(ctx)
+
+	projectID := d.Get("project_id").(int)
+	faultID := d.Get("fault_id").(int)
+	commentBody := d.Get("body").(string)
+	hbComment, err := c.CreateComment(projectID, faultID, commentBody)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("Comment %d has been created on fault %d", hbComment.ID, faultID)
+
+	d.SetId(fmt.Sprintf("%d/%d/%d", projectID, faultID, hbComment.ID))
+	d.Set("comment_id", hbComment.ID)
+	d.Set("last_updated", time.Now().Format(time.RFC850))
+
+	return resourceFaultCommentRead(ctx, d, m)
+}
+
+func resourceFaultCommentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*hbc.HoneybadgerClient).WithContext(ctx)
+
+	if d.HasChange("body") {
+		projectID := d.Get("project_id").(int)
+		faultID := d.Get("fault_id").(int)
+		commentID := d.Get("comment_id").(int)
+		err := c.UpdateComment(projectID, faultID, commentID, d.Get("body").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		d.Set("last_updated", time.Now().Format(time.RFC850))
+	}
+
+	return resourceFaultCommentRead(ctx, d, m)
+}
+
+func resourceFaultCommentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*hbc.HoneybadgerClient).WithContext(ctx)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	projectID := d.Get("project_id").(int)
+	faultID := d.Get("fault_id").(int)
+	commentID := d.Get("comment_id").(int)
+	err := c.DeleteComment(projectID, faultID, commentID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceFaultCommentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*hbc.HoneybadgerClient).WithContext(ctx)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	projectID := d.Get("project_id").(int)
+	faultID := d.Get("fault_id").(int)
+	commentID := d.Get("comment_id").(int)
+	comment, err := c.FindCommentByID(projectID, faultID, commentID)
+	if errors.Is(err, hbc.ErrCommentNotFound) {
+		log.Printf("Comment %d not found on fault %d, removing it from state", commentID, faultID)
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("body", comment.Body)
+	d.Set("author", comment.Author)
+	d.Set("created_at", comment.CreatedAt)
+
+	return diags
+}
+
+func resourceFaultCommentImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("unexpected format of ID (%s), expected project_id/fault_id/comment_id", d.Id())
+	}
+
+	var ids []int
+	for _, part := range parts {
+		id, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, fmt.Errorf("unexpected format of ID (%s), expected project_id/fault_id/comment_id", d.Id())
+		}
+		ids = append(ids, id)
+	}
+
+	d.Set("project_id", ids[0])
+	d.Set("fault_id", ids[1])
+	d.Set("comment_id", ids[2])
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,79 @@
+package honeybadger
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	hbc "terraform-provider-honeybadger/cli"
+)
+
+func TestAccHoneybadgerFaultCommentBasic(t *testing.T) {
+	projectID := 1234
+	faultID := 999
+	body := "Runbook: https://wiki.sequra.es/runbooks/timeouts"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckHoneybadgerFaultCommentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckHoneybadgerFaultCommentConfigBasic(projectID, faultID, body),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHoneybadgerFaultCommentExists("honeybadger_fault_comment.test"),
+					resource.TestCheckResourceAttr("honeybadger_fault_comment.test", "body", body),
+				),
+			},
+		},
+	})
+}
+func testAccCheckHoneybadgerFaultCommentConfigBasic(projectID int, faultID int, body string) string {
+	return fmt.Sprintf(`
+	resource "honeybadger_fault_comment" "test" {
+		project_id = %d
+		fault_id   = %d
+		body       = "%s"
+	}
+	`, projectID, faultID, body)
+}
+
+func testAccCheckHoneybadgerFaultCommentDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*hbc.HoneybadgerClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "honeybadger_fault_comment" {
+			continue
+		}
+
+		projectID, _ := strconv.Atoi(rs.Primary.Attributes["project_id"])
+		faultID, _ := strconv.Atoi(rs.Primary.Attributes["fault_id"])
+		commentID, _ := strconv.Atoi(rs.Primary.Attributes["comment_id"])
+
+		_, err := c.FindCommentByID(projectID, faultID, commentID)
+		if err == nil {
+			return fmt.Errorf("Comment %d still exists", commentID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckHoneybadgerFaultCommentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CommentID set")
+		}
+
+		return nil
+	}
+}
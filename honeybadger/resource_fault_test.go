@@ -0,0 +1,53 @@
+package honeybadger
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccHoneybadgerFaultBasic(t *testing.T) {
+	projectID := 1234
+	faultID := 999
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckHoneybadgerFaultConfigBasic(projectID, faultID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHoneybadgerFaultExists("honeybadger_fault.test"),
+					resource.TestCheckResourceAttr("honeybadger_fault.test", "ignored", "true"),
+				),
+			},
+		},
+	})
+}
+func testAccCheckHoneybadgerFaultConfigBasic(projectID int, faultID int) string {
+	return fmt.Sprintf(`
+	resource "honeybadger_fault" "test" {
+		project_id = %d
+		fault_id   = %d
+		ignored    = true
+	}
+	`, projectID, faultID)
+}
+
+func testAccCheckHoneybadgerFaultExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No FaultID set")
+		}
+
+		return nil
+	}
+}
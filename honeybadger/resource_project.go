@@ -2,13 +2,17 @@ package honeybadger
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"strconv"
 	"time"
 
 	hbc "terraform-provider-honeybadger/cli"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceProject() *schema.Resource {
@@ -17,6 +21,11 @@ func resourceProject() *schema.Resource {
 		ReadContext:   resourceProjectRead,
 		UpdateContext: resourceProjectUpdate,
 		DeleteContext: resourceProjectDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(projectDeleteTimeout),
+		},
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
 				Type:     schema.TypeString,
@@ -24,13 +33,22 @@ func resourceProject() *schema.Resource {
 				Computed: true,
 			},
 			"name": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
 			},
 			"language": &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: false,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     false,
+				Description:  "One of `js`, `elixir`, `golang`, `java`, `node`, `php`, `python`, `ruby` or `other`.",
+				ValidateFunc: validation.StringInSlice(projectLanguages, false),
+			},
+			"api_key": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "API key used to report errors and deploys for this project.",
 			},
 		},
 		Importer: &schema.ResourceImporter{
@@ -40,7 +58,7 @@ func resourceProject() *schema.Resource {
 }
 
 func resourceProjectCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*hbc.HoneybadgerClient)
+	c := m.(*hbc.HoneybadgerClient).WithContext(ctx)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -61,7 +79,7 @@ func resourceProjectCreate(ctx context.Context, d *schema.ResourceData, m interf
 }
 
 func resourceProjectUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*hbc.HoneybadgerClient)
+	c := m.(*hbc.HoneybadgerClient).WithContext(ctx)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -92,7 +110,7 @@ func resourceProjectUpdate(ctx context.Context, d *schema.ResourceData, m interf
 }
 
 func resourceProjectDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*hbc.HoneybadgerClient)
+	c := m.(*hbc.HoneybadgerClient).WithContext(ctx)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -113,13 +131,28 @@ func resourceProjectDelete(ctx context.Context, d *schema.ResourceData, m interf
 		return diag.FromErr(err)
 	}
 
+	// Big projects are deleted in the background, they are still listed until their data is gone
+	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *retry.RetryError {
+		_, err := c.FindProjectByID(projectID)
+		if errors.Is(err, hbc.ErrProjectNotFound) {
+			return nil
+		}
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+		return retry.RetryableError(fmt.Errorf("project %s with ID %d is still being deleted", projectName, projectID))
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	d.SetId("")
 
 	return diags
 }
 
 func resourceProjectRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*hbc.HoneybadgerClient)
+	c := m.(*hbc.HoneybadgerClient).WithContext(ctx)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -136,6 +169,7 @@ func resourceProjectRead(ctx context.Context, d *schema.ResourceData, m interfac
 	}
 
 	d.Set("name", project.Name)
+	d.Set("api_key", project.Token)
 
 	return diags
 }
@@ -15,9 +15,9 @@ func TestAccHoneybadgerProjectBasic(t *testing.T) {
 	projectName := "New Project"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckHoneybadgerProjectDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckHoneybadgerProjectDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckHoneybadgerProjectConfigBasic(projectName),
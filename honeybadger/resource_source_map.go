@@ -0,0 +1,176 @@
+package honeybadger
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"log"
+	"os"
+
+	hbc "terraform-provider-honeybadger/cli"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceSourceMap() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSourceMapCreate,
+		ReadContext:   resourceSourceMapRead,
+		DeleteContext: resourceSourceMapDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+		CustomizeDiff: resourceSourceMapCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"api_key": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				Description:  "API key of the JavaScript project, e.g. `honeybadger_project.app.api_key`.",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"minified_url": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "URL the minified file is served from. Wildcards are supported, e.g. `https://*.sequra.es/app.min.js`.",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"revision": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"minified_file": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Path to the minified JavaScript file.",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"source_map": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Path to the source map of the minified file.",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"minified_file_sha256": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+				ForceNew: true,
+			},
+			"source_map_sha256": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceSourceMapCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*hbc.HoneybadgerClient).WithContext(ctx)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	minifiedURL := d.Get("minified_url").(string)
+	revision := d.Get("revision").(string)
+	minifiedFile := d.Get("minified_file").(string)
+	sourceMapFile := d.Get("source_map").(string)
+
+	// Hash the uploaded content, the files may have been built after the plan
+	minifiedFileHash, err := fileSha256(minifiedFile)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	sourceMapHash, err := fileSha256(sourceMapFile)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = c.UploadSourceMap(d.Get("api_key").(string), minifiedURL, revision, minifiedFile, sourceMapFile)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("Source map %s for %s has been uploaded for revision %s", sourceMapFile, minifiedURL, revision)
+
+	d.SetId(sourceMapHash)
+	d.Set("minified_file_sha256", minifiedFileHash)
+	d.Set("source_map_sha256", sourceMapHash)
+
+	return diags
+}
+
+func resourceSourceMapRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	// Uploaded source maps cannot be read back, the hashes in the state are the source of truth
+	return diags
+}
+
+func resourceSourceMapDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	// Source maps expire on the Honeybadger side, destroying the resource only forgets it
+	d.SetId("")
+
+	return diags
+}
+
+// resourceSourceMapCustomizeDiff - Re-upload only when the content of the files changes. Files that
+// are not built yet at plan time keep the hash of the last upload, unless their path changes
+func resourceSourceMapCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	files := map[string]string{
+		"minified_file_sha256": "minified_file",
+		"source_map_sha256":    "source_map",
+	}
+
+	for attribute, pathAttribute := range files {
+		if d.HasChange(pathAttribute) {
+			if err := d.SetNewComputed(attribute); err != nil {
+				return err
+			}
+			continue
+		}
+
+		path := d.Get(pathAttribute).(string)
+		hash, err := fileSha256(path)
+		if err != nil {
+			log.Printf("Unable to hash %s at plan time, keeping the hash of the last upload: %s", path, err)
+			continue
+		}
+
+		if d.Get(attribute).(string) != hash {
+			if err := d.SetNew(attribute, hash); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func fileSha256(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
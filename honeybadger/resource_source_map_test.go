@@ -0,0 +1,109 @@
+package honeybadger
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccHoneybadgerSourceMapBasic(t *testing.T) {
+	apiKey := "hbp_123"
+	dir := t.TempDir()
+	minifiedFile := filepath.Join(dir, "app.min.js")
+	sourceMapFile := filepath.Join(dir, "app.min.js.map")
+	_ = os.WriteFile(minifiedFile, []byte("var a=1;"), 0644)
+	_ = os.WriteFile(sourceMapFile, []byte(`{"version":3}`), 0644)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckHoneybadgerSourceMapConfigBasic(apiKey, minifiedFile, sourceMapFile),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHoneybadgerSourceMapExists("honeybadger_source_map.test"),
+				),
+			},
+		},
+	})
+}
+func testAccCheckHoneybadgerSourceMapConfigBasic(apiKey string, minifiedFile string, sourceMapFile string) string {
+	return fmt.Sprintf(`
+	resource "honeybadger_source_map" "test" {
+		api_key       = "%s"
+		minified_url  = "https://cdn.sequra.es/app.min.js"
+		revision      = "b6826b8"
+		minified_file = "%s"
+		source_map    = "%s"
+	}
+	`, apiKey, minifiedFile, sourceMapFile)
+}
+
+func testAccCheckHoneybadgerSourceMapExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.Attributes["source_map_sha256"] == "" {
+			return fmt.Errorf("No source map hash set")
+		}
+
+		return nil
+	}
+}
+
+func TestResourceSourceMapCustomizeDiff(t *testing.T) {
+	dir := t.TempDir()
+	minifiedFile := filepath.Join(dir, "app.min.js")
+	sourceMap := filepath.Join(dir, "app.min.js.map")
+
+	state := &terraform.InstanceState{
+		ID: "app.min.js",
+		Attributes: map[string]string{
+			"id":                   "app.min.js",
+			"api_key":              "abcdef",
+			"minified_url":         "https://*.sequra.es/app.min.js",
+			"revision":             "b6826b8",
+			"minified_file":        minifiedFile,
+			"source_map":           sourceMap,
+			"minified_file_sha256": "previous",
+			"source_map_sha256":    "previous",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"api_key":       "abcdef",
+		"minified_url":  "https://*.sequra.es/app.min.js",
+		"revision":      "b6826b8",
+		"minified_file": minifiedFile,
+		"source_map":    sourceMap,
+	})
+
+	// The files are not built yet, so the hashes of the last upload are kept
+	diff, err := resourceSourceMap().Diff(context.Background(), state, config, nil)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if diff != nil && diff.RequiresNew() {
+		t.Fatalf("missing files must not replace the source map: %v", diff)
+	}
+
+	if err := os.WriteFile(minifiedFile, []byte("var a=1;"), 0644); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	diff, err = resourceSourceMap().Diff(context.Background(), state, config, nil)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if diff == nil || !diff.RequiresNew() || diff.Attributes["minified_file_sha256"] == nil {
+		t.Fatalf("a changed file must replace the source map: %v", diff)
+	}
+}
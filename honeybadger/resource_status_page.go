@@ -0,0 +1,224 @@
+package honeybadger
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	hbc "terraform-provider-honeybadger/cli"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceStatusPage() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceStatusPageCreate,
+		ReadContext:   resourceStatusPageRead,
+		UpdateContext: resourceStatusPageUpdate,
+		DeleteContext: resourceStatusPageDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+		Schema: map[string]*schema.Schema{
+			"last_updated": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"account_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"domain": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Custom domain the status page is served from, e.g. `status.example.com`.",
+			},
+			"visibility": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "public",
+				Description:  "Either `public` or `private`. Private status pages are only visible to account members.",
+				ValidateFunc: validation.StringInSlice([]string{"public", "private"}, false),
+			},
+			"site": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Uptime sites shown on the status page, in order.",
+				Elem:        statusPageItemSchema(),
+			},
+			"check_in": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Check-ins shown on the status page, in order.",
+				Elem:        statusPageItemSchema(),
+			},
+			"url": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceStatusPageImport,
+		},
+	}
+}
+
+func statusPageItemSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"display_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name shown on the status page. The monitor name is used when empty.",
+			},
+		},
+	}
+}
+
+func resourceStatusPageCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*hbc.HoneybadgerClient).WithContext(ctx)
+
+	accountID := d.Get("account_id").(string)
+	hbStatusPage, err := c.CreateStatusPage(accountID, expandStatusPage(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(hbStatusPage.ID))
+	d.Set("last_updated", time.Now().Format(time.RFC850))
+
+	return resourceStatusPageRead(ctx, d, m)
+}
+
+func resourceStatusPageUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*hbc.HoneybadgerClient).WithContext(ctx)
+
+	if d.HasChanges("name", "domain", "visibility", "site", "check_in") {
+		accountID := d.Get("account_id").(string)
+		statusPageID, _ := strconv.Atoi(d.Id())
+		err := c.UpdateStatusPage(accountID, statusPageID, expandStatusPage(d))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		d.Set("last_updated", time.Now().Format(time.RFC850))
+	}
+
+	return resourceStatusPageRead(ctx, d, m)
+}
+
+func resourceStatusPageDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*hbc.HoneybadgerClient).WithContext(ctx)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	accountID := d.Get("account_id").(string)
+	statusPageID, _ := strconv.Atoi(d.Id())
+	err := c.DeleteStatusPage(accountID, statusPageID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceStatusPageRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*hbc.HoneybadgerClient).WithContext(ctx)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	accountID := d.Get("account_id").(string)
+	statusPageID, _ := strconv.Atoi(d.Id())
+	statusPage, err := c.GetStatusPage(accountID, statusPageID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	visibility := "private"
+	if statusPage.Public {
+		visibility = "public"
+	}
+
+	d.Set("name", statusPage.Name)
+	d.Set("domain", statusPage.Domain)
+	d.Set("visibility", visibility)
+	d.Set("url", statusPage.URL)
+	if err := d.Set("site", flattenStatusPageItems(statusPage.Sites)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("check_in", flattenStatusPageItems(statusPage.CheckIns)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceStatusPageImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("unexpected format of ID (%s), expected account_id/status_page_id", d.Id())
+	}
+
+	d.Set("account_id", parts[0])
+	d.SetId(parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandStatusPage(d *schema.ResourceData) hbc.HoneybadgerStatusPage {
+	return hbc.HoneybadgerStatusPage{
+		Name:     d.Get("name").(string),
+		Domain:   d.Get("domain").(string),
+		Public:   d.Get("visibility").(string) == "public",
+		Sites:    expandStatusPageItems(d.Get("site").([]interface{})),
+		CheckIns: expandStatusPageItems(d.Get("check_in").([]interface{})),
+	}
+}
+
+func expandStatusPageItems(items []interface{}) []hbc.HoneybadgerStatusPageItem {
+	statusPageItems := []hbc.HoneybadgerStatusPageItem{}
+	for _, item := range items {
+		statusPageItem := item.(map[string]interface{})
+		statusPageItems = append(statusPageItems, hbc.HoneybadgerStatusPageItem{
+			ID:          statusPageItem["id"].(string),
+			DisplayName: statusPageItem["display_name"].(string),
+		})
+	}
+
+	return statusPageItems
+}
+
+func flattenStatusPageItems(statusPageItems []hbc.HoneybadgerStatusPageItem) []map[string]interface{} {
+	var items []map[string]interface{}
+	for _, statusPageItem := range statusPageItems {
+		items = append(items, map[string]interface{}{
+			"id":           statusPageItem.ID,
+			"display_name": statusPageItem.DisplayName,
+		})
+	}
+
+	return items
+}
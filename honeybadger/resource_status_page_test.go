@@ -0,0 +1,85 @@
+package honeybadger
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	hbc "terraform-provider-honeybadger/cli"
+)
+
+func TestAccHoneybadgerStatusPageBasic(t *testing.T) {
+	accountID := "abcdef"
+	name := "Acme status"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckHoneybadgerStatusPageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckHoneybadgerStatusPageConfigBasic(accountID, name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHoneybadgerStatusPageExists("honeybadger_status_page.test"),
+					resource.TestCheckResourceAttr("honeybadger_status_page.test", "visibility", "public"),
+					resource.TestCheckResourceAttr("honeybadger_status_page.test", "site.0.display_name", "Website"),
+				),
+			},
+		},
+	})
+}
+func testAccCheckHoneybadgerStatusPageConfigBasic(accountID string, name string) string {
+	return fmt.Sprintf(`
+	resource "honeybadger_status_page" "test" {
+		account_id = "%s"
+		name       = "%s"
+
+		site {
+			id           = "abc123"
+			display_name = "Website"
+		}
+	}
+	`, accountID, name)
+}
+
+func testAccCheckHoneybadgerStatusPageDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*hbc.HoneybadgerClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "honeybadger_status_page" {
+			continue
+		}
+
+		accountID := rs.Primary.Attributes["account_id"]
+		statusPageID, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = c.GetStatusPage(accountID, statusPageID)
+		if err == nil {
+			return fmt.Errorf("Status page %d still exists", statusPageID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckHoneybadgerStatusPageExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No StatusPageID set")
+		}
+
+		return nil
+	}
+}
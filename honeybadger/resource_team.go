@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceTeam() *schema.Resource {
@@ -17,6 +18,11 @@ func resourceTeam() *schema.Resource {
 		ReadContext:   resourceTeamRead,
 		UpdateContext: resourceTeamUpdate,
 		DeleteContext: resourceTeamDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
 				Type:     schema.TypeString,
@@ -24,8 +30,9 @@ func resourceTeam() *schema.Resource {
 				Computed: true,
 			},
 			"name": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
 			},
 		},
 		Importer: &schema.ResourceImporter{
@@ -35,7 +42,7 @@ func resourceTeam() *schema.Resource {
 }
 
 func resourceTeamCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*hbc.HoneybadgerClient)
+	c := m.(*hbc.HoneybadgerClient).WithContext(ctx)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -55,7 +62,7 @@ func resourceTeamCreate(ctx context.Context, d *schema.ResourceData, m interface
 }
 
 func resourceTeamUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*hbc.HoneybadgerClient)
+	c := m.(*hbc.HoneybadgerClient).WithContext(ctx)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -85,7 +92,7 @@ func resourceTeamUpdate(ctx context.Context, d *schema.ResourceData, m interface
 }
 
 func resourceTeamDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*hbc.HoneybadgerClient)
+	c := m.(*hbc.HoneybadgerClient).WithContext(ctx)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -112,7 +119,7 @@ func resourceTeamDelete(ctx context.Context, d *schema.ResourceData, m interface
 }
 
 func resourceTeamRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*hbc.HoneybadgerClient)
+	c := m.(*hbc.HoneybadgerClient).WithContext(ctx)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -0,0 +1,315 @@
+package honeybadger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	hbc "terraform-provider-honeybadger/cli"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &teamMemberResource{}
+	_ resource.ResourceWithConfigure   = &teamMemberResource{}
+	_ resource.ResourceWithImportState = &teamMemberResource{}
+)
+
+// NewTeamMemberResource -
+func NewTeamMemberResource() resource.Resource {
+	return &teamMemberResource{}
+}
+
+// teamMemberResource - A single membership of a user in a team, so every team can be owned by a
+// different configuration
+type teamMemberResource struct {
+	client *hbc.HoneybadgerClient
+}
+
+type teamMemberResourceModel struct {
+	ID          types.String   `tfsdk:"id"`
+	LastUpdated types.String   `tfsdk:"last_updated"`
+	TeamID      types.Int64    `tfsdk:"team_id"`
+	Email       types.String   `tfsdk:"email"`
+	Admin       types.Bool     `tfsdk:"admin"`
+	UserID      types.Int64    `tfsdk:"user_id"`
+	Pending     types.Bool     `tfsdk:"pending"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *teamMemberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_member"
+}
+
+func (r *teamMemberResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"team_id": schema.Int64Attribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"email": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(emailRegexp, emailRegexpMessage),
+				},
+			},
+			"admin": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"user_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "ID of the team member, or of the invitation while it is pending.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"pending": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the user has not accepted the invitation to the team yet.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *teamMemberResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// The provider is not configured yet while validating the configuration
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*hbc.HoneybadgerClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data", fmt.Sprintf("Expected *cli.HoneybadgerClient, got %T", req.ProviderData))
+		return
+	}
+
+	r.client = c
+}
+
+func (r *teamMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan teamMemberResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	teamID := int(plan.TeamID.ValueInt64())
+	userEmail := plan.Email.ValueString()
+	log.Printf("User %s will be inserted into team %d", userEmail, teamID)
+	err := r.client.WithContext(ctx).CreateUser(userEmail, plan.Admin.ValueBool(), teamID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create team member", err.Error())
+		return
+	}
+
+	user, err := r.client.WithContext(ctx).GetUserForTeam(userEmail, teamID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read team member", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(strconv.Itoa(teamID) + "/" + userEmail)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	plan.Admin = types.BoolValue(user.IsAdmin)
+	plan.UserID = types.Int64Value(int64(user.ID))
+	plan.Pending = types.BoolValue(user.IsPending)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *teamMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state teamMemberResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := r.client.WithContext(ctx).GetUserForTeam(state.Email.ValueString(), int(state.TeamID.ValueInt64()))
+	if errors.Is(err, hbc.ErrUserNotFound) {
+		log.Printf("User %s is not a member of team %d anymore, removing it from the state", state.Email.ValueString(), state.TeamID.ValueInt64())
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read team member", err.Error())
+		return
+	}
+
+	state.Admin = types.BoolValue(user.IsAdmin)
+	state.UserID = types.Int64Value(int64(user.ID))
+	state.Pending = types.BoolValue(user.IsPending)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *teamMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state teamMemberResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// The team and the email force a new membership, only the admin flag is updated in place.
+	// Pending invitations are not users of the team yet, so they are updated through their own API
+	teamID := int(state.TeamID.ValueInt64())
+	userID := int(state.UserID.ValueInt64())
+	plan.LastUpdated = state.LastUpdated
+	if !plan.Admin.Equal(state.Admin) {
+		var err error
+		log.Printf("User %s with ID %d will be updated in team %d with admin value %t", state.Email.ValueString(), userID, teamID, plan.Admin.ValueBool())
+		if state.Pending.ValueBool() {
+			err = r.client.WithContext(ctx).UpdateTeamInvitation(userID, plan.Admin.ValueBool(), teamID)
+		} else {
+			err = r.client.WithContext(ctx).UpdateUser(userID, plan.Admin.ValueBool(), teamID)
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to update team member", err.Error())
+			return
+		}
+
+		plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	}
+
+	user, err := r.client.WithContext(ctx).GetUserForTeam(state.Email.ValueString(), teamID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read team member", err.Error())
+		return
+	}
+
+	plan.Admin = types.BoolValue(user.IsAdmin)
+	plan.UserID = types.Int64Value(int64(user.ID))
+	plan.Pending = types.BoolValue(user.IsPending)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *teamMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state teamMemberResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	var err error
+	teamID := int(state.TeamID.ValueInt64())
+	userID := int(state.UserID.ValueInt64())
+	log.Printf("User %s will be deleted from team %d", state.Email.ValueString(), teamID)
+	if state.Pending.ValueBool() {
+		err = r.client.WithContext(ctx).DeleteTeamInvitation(userID, teamID)
+	} else {
+		err = r.client.WithContext(ctx).DeleteUser(userID, teamID)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to delete team member", err.Error())
+		return
+	}
+}
+
+// ImportState - The ID is the team and the email of the user, as in team_id/email
+func (r *teamMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("unexpected format of ID (%s), expected team_id/email", req.ID))
+		return
+	}
+
+	teamID, err := strconv.Atoi(parts[0])
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("invalid team_id in ID (%s): %s", req.ID, err))
+		return
+	}
+
+	_, err = r.client.WithContext(ctx).GetUserForTeam(parts[1], teamID)
+	if errors.Is(err, hbc.ErrUserNotFound) {
+		resp.Diagnostics.AddError("Team member not found", fmt.Sprintf("User %s is not a member of team %d", parts[1], teamID))
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read team member", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), int64(teamID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("email"), parts[1])...)
+}
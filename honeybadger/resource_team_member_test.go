@@ -0,0 +1,94 @@
+package honeybadger
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	hbc "terraform-provider-honeybadger/cli"
+)
+
+func TestAccHoneybadgerTeamMemberBasic(t *testing.T) {
+	teamID := 1234
+	email := "test.sequra@sequra.es"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckHoneybadgerTeamMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckHoneybadgerTeamMemberConfigBasic(teamID, email, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHoneybadgerTeamMemberExists("honeybadger_team_member.test"),
+					resource.TestCheckResourceAttr("honeybadger_team_member.test", "admin", "false"),
+				),
+			},
+			{
+				Config: testAccCheckHoneybadgerTeamMemberConfigBasic(teamID, email, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("honeybadger_team_member.test", "admin", "true"),
+				),
+			},
+			{
+				ResourceName:            "honeybadger_team_member.test",
+				ImportState:             true,
+				ImportStateId:           fmt.Sprintf("%d/%s", teamID, email),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+		},
+	})
+}
+func testAccCheckHoneybadgerTeamMemberConfigBasic(teamID int, email string, admin bool) string {
+	return fmt.Sprintf(`
+	resource "honeybadger_team_member" "test" {
+		team_id = %d
+		email   = "%s"
+		admin   = %t
+	}
+	`, teamID, email, admin)
+}
+
+func testAccCheckHoneybadgerTeamMemberDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*hbc.HoneybadgerClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "honeybadger_team_member" {
+			continue
+		}
+
+		teamID, err := strconv.Atoi(rs.Primary.Attributes["team_id"])
+		if err != nil {
+			return err
+		}
+		email := rs.Primary.Attributes["email"]
+
+		_, err = c.GetUserForTeam(email, teamID)
+		if !errors.Is(err, hbc.ErrUserNotFound) {
+			return fmt.Errorf("User %s still belongs to team %d", email, teamID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckHoneybadgerTeamMemberExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No TeamMember ID set")
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,461 @@
+package honeybadger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	hbc "terraform-provider-honeybadger/cli"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &teamMembersResource{}
+	_ resource.ResourceWithConfigure      = &teamMembersResource{}
+	_ resource.ResourceWithImportState    = &teamMembersResource{}
+	_ resource.ResourceWithModifyPlan     = &teamMembersResource{}
+	_ resource.ResourceWithValidateConfig = &teamMembersResource{}
+)
+
+// NewTeamMembersResource -
+func NewTeamMembersResource() resource.Resource {
+	return &teamMembersResource{}
+}
+
+// teamMembersResource - The complete member list of a team, including pending invitations. Anyone
+// not listed is removed from the team, except its owner
+type teamMembersResource struct {
+	client *hbc.HoneybadgerClient
+}
+
+type teamMembersResourceModel struct {
+	ID             types.String             `tfsdk:"id"`
+	LastUpdated    types.String             `tfsdk:"last_updated"`
+	TeamID         types.Int64              `tfsdk:"team_id"`
+	DryRunRemovals types.Bool               `tfsdk:"dry_run_removals"`
+	Members        []teamMembersMemberModel `tfsdk:"member"`
+	Timeouts       timeouts.Value           `tfsdk:"timeouts"`
+}
+
+type teamMembersMemberModel struct {
+	Email types.String `tfsdk:"email"`
+	Admin types.Bool   `tfsdk:"admin"`
+}
+
+// teamMembership - A member of the team, or a pending invitation to it
+type teamMembership struct {
+	ID        int
+	Email     string
+	IsAdmin   bool
+	IsPending bool
+}
+
+func (r *teamMembersResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_members"
+}
+
+func (r *teamMembersResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"team_id": schema.Int64Attribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"dry_run_removals": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Only warn about the members that are not listed, instead of removing them from the team.",
+			},
+			"member": schema.SetNestedAttribute{
+				Required:    true,
+				Description: "Every member of the team, and every pending invitation to it. The team owner is never listed.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"email": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(emailRegexp, emailRegexpMessage),
+							},
+						},
+						"admin": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  booldefault.StaticBool(false),
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// ValidateConfig - An email listed twice with different admin flags would be updated back and forth
+func (r *teamMembersResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var members types.Set
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("member"), &members)...)
+	if resp.Diagnostics.HasError() || members.IsNull() || members.IsUnknown() {
+		return
+	}
+
+	var memberModels []teamMembersMemberModel
+	resp.Diagnostics.Append(members.ElementsAs(ctx, &memberModels, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := map[string]bool{}
+	for _, member := range memberModels {
+		if member.Email.IsNull() || member.Email.IsUnknown() {
+			continue
+		}
+		email := strings.ToLower(member.Email.ValueString())
+		if seen[email] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("member"),
+				"Duplicate member",
+				fmt.Sprintf("%s is listed more than once, each email can only be listed once per team", member.Email.ValueString()),
+			)
+		}
+		seen[email] = true
+	}
+}
+
+func (r *teamMembersResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// The provider is not configured yet while validating the configuration
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*hbc.HoneybadgerClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data", fmt.Sprintf("Expected *cli.HoneybadgerClient, got %T", req.ProviderData))
+		return
+	}
+
+	r.client = c
+}
+
+// ModifyPlan - Removals skipped by dry_run_removals are only visible as a diff of the member list, so
+// they are listed in a warning too
+func (r *teamMembersResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state teamMembersResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.DryRunRemovals.ValueBool() {
+		return
+	}
+
+	desired := map[string]bool{}
+	for _, member := range plan.Members {
+		desired[member.Email.ValueString()] = true
+	}
+	var removals []string
+	for _, member := range state.Members {
+		if !desired[member.Email.ValueString()] {
+			removals = append(removals, member.Email.ValueString())
+		}
+	}
+
+	addDryRunRemovalsWarning(&resp.Diagnostics, state.TeamID.ValueInt64(), removals)
+}
+
+func (r *teamMembersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan teamMembersResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	err := r.applyMembers(ctx, &plan, &resp.Diagnostics)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to update team members", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(strconv.FormatInt(plan.TeamID.ValueInt64(), 10))
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *teamMembersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state teamMembersResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	memberships, err := r.readMemberships(ctx, int(state.TeamID.ValueInt64()))
+	if errors.Is(err, hbc.ErrTeamNotFound) {
+		log.Printf("Team %d does not exist anymore, removing its members from the state", state.TeamID.ValueInt64())
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read team members", err.Error())
+		return
+	}
+
+	// Emails are kept as they are written in the configuration, Honeybadger may store them in another case
+	var members []teamMembersMemberModel
+	for _, membership := range memberships {
+		email := membership.Email
+		for _, member := range state.Members {
+			if strings.EqualFold(member.Email.ValueString(), email) {
+				email = member.Email.ValueString()
+				break
+			}
+		}
+
+		members = append(members, teamMembersMemberModel{
+			Email: types.StringValue(email),
+			Admin: types.BoolValue(membership.IsAdmin),
+		})
+	}
+	state.Members = members
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *teamMembersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state teamMembersResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	err := r.applyMembers(ctx, &plan, &resp.Diagnostics)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to update team members", err.Error())
+		return
+	}
+
+	plan.ID = state.ID
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete - Removes every listed member from the team, unless dry_run_removals is set
+func (r *teamMembersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state teamMembersResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Nobody is listed anymore, so everybody but the owner is removed
+	state.Members = nil
+	err := r.applyMembers(ctx, &state, &resp.Diagnostics)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to remove team members", err.Error())
+		return
+	}
+}
+
+// ImportState - The ID is the team id
+func (r *teamMembersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	teamID, err := strconv.Atoi(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("invalid team_id in ID (%s): %s", req.ID, err))
+		return
+	}
+
+	_, err = r.client.WithContext(ctx).FindTeamByID(teamID)
+	if err != nil {
+		resp.Diagnostics.AddError("Team not found", fmt.Sprintf("Team %d: %s", teamID, err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), int64(teamID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("dry_run_removals"), false)...)
+}
+
+// readMemberships - Members of the team and pending invitations to it by lower case email, leaving the
+// owner out, as emails are not case sensitive
+func (r *teamMembersResource) readMemberships(ctx context.Context, teamID int) (map[string]teamMembership, error) {
+	memberships := map[string]teamMembership{}
+
+	team, err := r.client.WithContext(ctx).FindTeamByID(teamID)
+	if err != nil {
+		return memberships, err
+	}
+
+	users, err := r.client.WithContext(ctx).GetUsers(teamID)
+	if err != nil {
+		return memberships, err
+	}
+
+	for _, user := range users {
+		memberships[strings.ToLower(user.Email)] = teamMembership{ID: user.ID, Email: user.Email, IsAdmin: user.IsAdmin}
+	}
+	for _, invitation := range team.Invitations {
+		if _, ok := memberships[strings.ToLower(invitation.Email)]; ok {
+			continue
+		}
+		memberships[strings.ToLower(invitation.Email)] = teamMembership{ID: invitation.ID, Email: invitation.Email, IsAdmin: invitation.IsAdmin, IsPending: true}
+	}
+	delete(memberships, strings.ToLower(team.Owner.Email))
+
+	return memberships, nil
+}
+
+// applyMembers - Invites the missing members, updates the admin flags that differ and removes the
+// members that are not listed, or only warns about them with dry_run_removals
+func (r *teamMembersResource) applyMembers(ctx context.Context, model *teamMembersResourceModel, diags *diag.Diagnostics) error {
+	teamID := int(model.TeamID.ValueInt64())
+
+	memberships, err := r.readMemberships(ctx, teamID)
+	if err != nil {
+		return err
+	}
+
+	desired := map[string]bool{}
+	for _, member := range model.Members {
+		email := member.Email.ValueString()
+		isAdmin := member.Admin.ValueBool()
+		desired[strings.ToLower(email)] = true
+
+		membership, ok := memberships[strings.ToLower(email)]
+		switch {
+		case !ok:
+			log.Printf("User %s will be invited to team %d with admin to %t", email, teamID, isAdmin)
+			err = r.client.WithContext(ctx).CreateUser(email, isAdmin, teamID)
+		case membership.IsAdmin == isAdmin:
+			continue
+		case membership.IsPending:
+			log.Printf("Invitation of %s to team %d will be updated with admin value %t", email, teamID, isAdmin)
+			err = r.client.WithContext(ctx).UpdateTeamInvitation(membership.ID, isAdmin, teamID)
+		default:
+			log.Printf("User %s with ID %d will be updated in team %d with admin value %t", email, membership.ID, teamID, isAdmin)
+			err = r.client.WithContext(ctx).UpdateUser(membership.ID, isAdmin, teamID)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	var removals []string
+	for key, membership := range memberships {
+		if !desired[key] {
+			removals = append(removals, membership.Email)
+		}
+	}
+	sort.Strings(removals)
+
+	if model.DryRunRemovals.ValueBool() {
+		addDryRunRemovalsWarning(diags, int64(teamID), removals)
+		return nil
+	}
+
+	for _, email := range removals {
+		membership := memberships[strings.ToLower(email)]
+		if membership.IsPending {
+			log.Printf("Invitation of %s to team %d will be cancelled", email, teamID)
+			err = r.client.WithContext(ctx).DeleteTeamInvitation(membership.ID, teamID)
+		} else {
+			log.Printf("User %s with ID %d will be deleted from team %d", email, membership.ID, teamID)
+			err = r.client.WithContext(ctx).DeleteUser(membership.ID, teamID)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func addDryRunRemovalsWarning(diags *diag.Diagnostics, teamID int64, removals []string) {
+	if len(removals) == 0 {
+		return
+	}
+
+	diags.AddWarning(
+		"Team members are not removed",
+		fmt.Sprintf("dry_run_removals is set, so these members of team %d are kept although they are not listed: %s", teamID, strings.Join(removals, ", ")),
+	)
+}
@@ -0,0 +1,112 @@
+package honeybadger
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	hbc "terraform-provider-honeybadger/cli"
+)
+
+func TestAccHoneybadgerTeamMembersBasic(t *testing.T) {
+	teamID := 1234
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckHoneybadgerTeamMembersDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckHoneybadgerTeamMembersConfigBasic(teamID, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHoneybadgerTeamMembersExists("honeybadger_team_members.test"),
+					resource.TestCheckResourceAttr("honeybadger_team_members.test", "member.#", "2"),
+					resource.TestCheckResourceAttr("honeybadger_team_members.test", "dry_run_removals", "false"),
+				),
+			},
+			{
+				Config: testAccCheckHoneybadgerTeamMembersConfigBasic(teamID, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("honeybadger_team_members.test", "member.*", map[string]string{
+						"email": "test.sequra@sequra.es",
+						"admin": "true",
+					}),
+				),
+			},
+			{
+				ResourceName:            "honeybadger_team_members.test",
+				ImportState:             true,
+				ImportStateId:           strconv.Itoa(teamID),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+		},
+	})
+}
+func testAccCheckHoneybadgerTeamMembersConfigBasic(teamID int, admin bool) string {
+	return fmt.Sprintf(`
+	resource "honeybadger_team_members" "test" {
+		team_id = %d
+
+		member = [
+			{
+				email = "test.sequra@sequra.es"
+				admin = %t
+			},
+			{
+				email = "test2.sequra@sequra.es"
+			},
+		]
+	}
+	`, teamID, admin)
+}
+
+func testAccCheckHoneybadgerTeamMembersDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*hbc.HoneybadgerClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "honeybadger_team_members" {
+			continue
+		}
+
+		teamID, err := strconv.Atoi(rs.Primary.Attributes["team_id"])
+		if err != nil {
+			return err
+		}
+
+		users, err := c.GetUsers(teamID)
+		if err != nil {
+			return err
+		}
+		team, err := c.FindTeamByID(teamID)
+		if err != nil {
+			return err
+		}
+		for _, user := range users {
+			if user.Email != team.Owner.Email {
+				return fmt.Errorf("User %s still belongs to team %d", user.Email, teamID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckHoneybadgerTeamMembersExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No TeamMembers ID set")
+		}
+
+		return nil
+	}
+}
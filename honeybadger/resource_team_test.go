@@ -15,9 +15,9 @@ func TestAccHoneybadgerTeamBasic(t *testing.T) {
 	teamName := "Test Team"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckHoneybadgerTeamDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckHoneybadgerTeamDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckHoneybadgerTeamConfigBasic(teamName),
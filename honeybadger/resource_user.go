@@ -2,226 +2,460 @@ package honeybadger
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"strconv"
+	"strings"
 	"time"
 
 	hbc "terraform-provider-honeybadger/cli"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-func resourceUser() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: resourceUserCreate,
-		ReadContext:   resourceUserRead,
-		UpdateContext: resourceUserUpdate,
-		DeleteContext: resourceUserDelete,
-		Schema: map[string]*schema.Schema{
-			"last_updated": &schema.Schema{
-				Type:     schema.TypeString,
+var (
+	_ resource.Resource                   = &userResource{}
+	_ resource.ResourceWithConfigure      = &userResource{}
+	_ resource.ResourceWithImportState    = &userResource{}
+	_ resource.ResourceWithValidateConfig = &userResource{}
+)
+
+// NewUserResource -
+func NewUserResource() resource.Resource {
+	return &userResource{}
+}
+
+type userResource struct {
+	client *hbc.HoneybadgerClient
+}
+
+type userResourceModel struct {
+	ID          types.String    `tfsdk:"id"`
+	LastUpdated types.String    `tfsdk:"last_updated"`
+	Email       types.String    `tfsdk:"email"`
+	Teams       []userTeamModel `tfsdk:"team"`
+	Timeouts    timeouts.Value  `tfsdk:"timeouts"`
+}
+
+type userTeamModel struct {
+	ID      types.Int64 `tfsdk:"id"`
+	IsAdmin types.Bool  `tfsdk:"is_admin"`
+	UserID  types.Int64 `tfsdk:"user_id"`
+}
+
+func (r *userResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (r *userResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
 				Optional: true,
 				Computed: true,
 			},
-			"email": &schema.Schema{
-				Type:     schema.TypeString,
+			"email": schema.StringAttribute{
 				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(emailRegexp, emailRegexpMessage),
+				},
 			},
-			"team": &schema.Schema{
-				Type:     schema.TypeSet,
-				Required: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"is_admin": &schema.Schema{
-							Type:     schema.TypeBool,
-							Optional: true,
-						},
-						"id": &schema.Schema{
-							Type:     schema.TypeInt,
+			"team": schema.SetNestedAttribute{
+				Required:    true,
+				Description: "Teams the user belongs to.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
 							Required: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
 						},
-						"user_id": &schema.Schema{
-							Type:     schema.TypeInt,
+						"is_admin": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  booldefault.StaticBool(false),
+						},
+						"user_id": schema.Int64Attribute{
 							Computed: true,
 						},
 					},
 				},
 			},
 		},
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
 
-func resourceUserCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*hbc.HoneybadgerClient)
+// ValidateConfig - Two team blocks for the same team would fight over the admin flag of the membership
+func (r *userResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var teams types.Set
 
-	// Warning or errors can be collected in a slice type
-	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("team"), &teams)...)
+	if resp.Diagnostics.HasError() || teams.IsNull() || teams.IsUnknown() {
+		return
+	}
 
-	userEmail := d.Get("email").(string)
-	teams := d.Get("team")
-	for _, item := range teams.(*schema.Set).List() {
-		team := item.(map[string]interface{})
-		teamID := team["id"].(int)
-		isAdmin := team["is_admin"].(bool)
-		err := c.CreateUser(userEmail, isAdmin, teamID)
-		if err != nil {
-			return diag.FromErr(err)
+	var teamModels []userTeamModel
+	resp.Diagnostics.Append(teams.ElementsAs(ctx, &teamModels, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := map[int64]bool{}
+	for _, team := range teamModels {
+		if team.ID.IsNull() || team.ID.IsUnknown() {
+			continue
 		}
-		log.Printf("User " + userEmail + " will be inserted into team  " + strconv.Itoa(teamID))
+		if seen[team.ID.ValueInt64()] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("team"),
+				"Duplicate team",
+				fmt.Sprintf("Team %d is listed more than once, each team can only be listed once per user", team.ID.ValueInt64()),
+			)
+		}
+		seen[team.ID.ValueInt64()] = true
 	}
+}
 
-	d.SetId(userEmail)
-	d.Set("last_updated", time.Now().Format(time.RFC850))
+func (r *userResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// The provider is not configured yet while validating the configuration
+	if req.ProviderData == nil {
+		return
+	}
 
-	resourceUserRead(ctx, d, m)
+	c, ok := req.ProviderData.(*hbc.HoneybadgerClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data", fmt.Sprintf("Expected *cli.HoneybadgerClient, got %T", req.ProviderData))
+		return
+	}
 
-	return diags
+	r.client = c
 }
 
-func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	userEmail := d.Id()
-	if d.HasChange("team") {
-		err := updateUserTeam(userEmail, d, m)
+func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan userResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	userEmail := plan.Email.ValueString()
+	for _, team := range plan.Teams {
+		teamID := int(team.ID.ValueInt64())
+		log.Printf("User %s will be inserted into team %d", userEmail, teamID)
+		err := r.client.WithContext(ctx).CreateUser(userEmail, team.IsAdmin.ValueBool(), teamID)
 		if err != nil {
-			return diag.FromErr(err)
+			resp.Diagnostics.AddError("Unable to create user", err.Error())
+			return
 		}
+	}
 
-		d.Set("last_updated", time.Now().Format(time.RFC850))
+	plan.ID = types.StringValue(userEmail)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	found, err := r.readUser(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read user", err.Error())
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError("Unable to read user", fmt.Sprintf("User %s was not found in any team after being created", userEmail))
+		return
 	}
 
-	return resourceUserRead(ctx, d, m)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
-func resourceUserDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*hbc.HoneybadgerClient)
+func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state userResourceModel
 
-	// Warning or errors can be collected in a slice type
-	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	userEmail := d.Id()
-	teams := d.Get("team")
-	for _, item := range teams.(*schema.Set).List() {
-		team := item.(map[string]interface{})
-		teamID := team["id"].(int)
-		userID := team["user_id"].(int)
-		err := c.DeleteUser(userID, teamID)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-		log.Printf("User " + userEmail + " will be deleted from team  " + strconv.Itoa(teamID))
+	found, err := r.readUser(ctx, &state)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read user", err.Error())
+		return
+	}
+	if !found {
+		log.Printf("User %s does not belong to any team, removing it from the state", state.ID.ValueString())
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state userResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	plan.LastUpdated = state.LastUpdated
+	changed, err := r.updateUserTeams(ctx, state.ID.ValueString(), state.Teams, plan.Teams)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to update user", err.Error())
+		return
+	}
+	if changed {
+		plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 	}
 
-	d.SetId("")
+	plan.ID = state.ID
+	found, err := r.readUser(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read user", err.Error())
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError("Unable to read user", fmt.Sprintf("User %s was not found in any team after being updated", plan.ID.ValueString()))
+		return
+	}
 
-	return diags
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
-func resourceUserRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*hbc.HoneybadgerClient)
+func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state userResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	for _, team := range state.Teams {
+		teamID := int(team.ID.ValueInt64())
+		log.Printf("User %s will be deleted from team %d", state.ID.ValueString(), teamID)
+		err := r.client.WithContext(ctx).DeleteUser(int(team.UserID.ValueInt64()), teamID)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to delete user", err.Error())
+			return
+		}
+	}
+}
 
-	// Warning or errors can be collected in a slice type
-	var diags diag.Diagnostics
+// ImportState - The ID is the email of the user, optionally followed by the teams to import, as in
+// email:team_id[,team_id]. All the teams of the user are imported when none is given
+func (r *userResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	userEmail, teamIDs, err := parseUserImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
 
-	var unstructuredUserTeams []map[string]interface{}
-	userEmail := d.Id()
-	userTeams, err := c.GetUserFromTeams(userEmail)
+	userTeams, err := r.client.WithContext(ctx).GetUserFromTeams(userEmail)
 	if err != nil {
-		return diag.FromErr(err)
+		resp.Diagnostics.AddError("Unable to read user", err.Error())
+		return
+	}
+	if len(userTeams) == 0 {
+		resp.Diagnostics.AddError("User not found", fmt.Sprintf("No team has a member with email %s", userEmail))
+		return
 	}
 
-	log.Printf("Reading user with email %s", userEmail)
+	userTeamsByID := map[int64]hbc.HoneybadgerUser{}
 	for _, user := range userTeams {
-		log.Printf("Found user with email %s in team %d", userEmail, user.TeamID)
-		unstructuredUserTeams = append(unstructuredUserTeams, map[string]interface{}{
-			"is_admin": user.IsAdmin,
-			"user_id":  user.ID,
-			"id":       user.TeamID,
-		})
+		userTeamsByID[int64(user.TeamID)] = user
+	}
 
+	var teams []userTeamModel
+	for _, teamID := range teamIDs {
+		user, ok := userTeamsByID[teamID]
+		if !ok {
+			resp.Diagnostics.AddError("User not found", fmt.Sprintf("User %s is not a member of team %d", userEmail, teamID))
+			return
+		}
+		teams = append(teams, userTeamModel{
+			ID:      types.Int64Value(teamID),
+			IsAdmin: types.BoolValue(user.IsAdmin),
+			UserID:  types.Int64Value(int64(user.ID)),
+		})
 	}
 
-	d.Set("email", userEmail)
-	if err := d.Set("team", unstructuredUserTeams); err != nil {
-		return diag.FromErr(err)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), userEmail)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("email"), userEmail)...)
+	if len(teams) > 0 {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team"), teams)...)
 	}
-	return diags
 }
 
-func updateUserTeam(userEmail string, d *schema.ResourceData, m interface{}) error {
-	c := m.(*hbc.HoneybadgerClient)
-	oldState, newState := d.GetChange("team")
+// readUser - Refreshes the teams of the user in the model. Only the teams already in the model are
+// managed, so teams of the user handled by other configurations are left out. It returns false when
+// the user does not belong to any of them anymore
+func (r *userResource) readUser(ctx context.Context, model *userResourceModel) (bool, error) {
+	userEmail := model.ID.ValueString()
+	log.Printf("Reading user with email %s", userEmail)
 
-	if oldState == nil {
-		oldState = new(schema.Set)
+	userTeams, err := r.client.WithContext(ctx).GetUserFromTeams(userEmail)
+	if err != nil {
+		return false, err
 	}
-	if newState == nil {
-		newState = new(schema.Set)
+
+	managedTeamIDs := map[int64]bool{}
+	for _, team := range model.Teams {
+		managedTeamIDs[team.ID.ValueInt64()] = true
+	}
+
+	var teams []userTeamModel
+	for _, user := range userTeams {
+		if len(managedTeamIDs) > 0 && !managedTeamIDs[int64(user.TeamID)] {
+			continue
+		}
+		log.Printf("Found user with email %s in team %d", userEmail, user.TeamID)
+		teams = append(teams, userTeamModel{
+			ID:      types.Int64Value(int64(user.TeamID)),
+			IsAdmin: types.BoolValue(user.IsAdmin),
+			UserID:  types.Int64Value(int64(user.ID)),
+		})
 	}
 
-	os := oldState.(*schema.Set)
-	ns := newState.(*schema.Set)
-	updateOperation := calculateRealDifference(os, ns).List()
-	removeOperation := os.Difference(ns).List()
-	addOperation := ns.Difference(os).List()
+	model.Email = types.StringValue(userEmail)
+	model.Teams = teams
+
+	return len(teams) > 0, nil
+}
+
+// updateUserTeams - Teams are matched by ID: the user is removed from the teams that are only in the
+// state, invited to the teams that are only in the plan, and updated in the teams whose admin flag changed
+func (r *userResource) updateUserTeams(ctx context.Context, userEmail string, stateTeams []userTeamModel, planTeams []userTeamModel) (bool, error) {
+	changed := false
+
+	stateTeamsByID := map[int64]userTeamModel{}
+	for _, team := range stateTeams {
+		stateTeamsByID[team.ID.ValueInt64()] = team
+	}
+	planTeamsByID := map[int64]userTeamModel{}
+	for _, team := range planTeams {
+		planTeamsByID[team.ID.ValueInt64()] = team
+	}
 
 	// Delete user from team
-	for _, operation := range removeOperation {
-		team := operation.(map[string]interface{})
-		teamID := team["id"].(int)
-		userID := team["user_id"].(int)
-		log.Printf("User %s with id %d it will be deleted from team %d", userEmail, userID, teamID)
-		err := c.DeleteUser(userID, teamID)
-		if err != nil {
-			return err
+	for teamID, team := range stateTeamsByID {
+		if _, ok := planTeamsByID[teamID]; ok {
+			continue
 		}
-	}
-	// Add user to a team
-	for _, operation := range addOperation {
-		team := operation.(map[string]interface{})
-		teamID := team["id"].(int)
-		isAdmin := team["is_admin"].(bool)
-		log.Printf("User %s it will be invited to team %d with admin to %t", userEmail, teamID, isAdmin)
-		err := c.CreateUser(userEmail, isAdmin, teamID)
+		userID := int(team.UserID.ValueInt64())
+		log.Printf("User %s with id %d will be deleted from team %d", userEmail, userID, teamID)
+		err := r.client.WithContext(ctx).DeleteUser(userID, int(teamID))
 		if err != nil {
-			return err
+			return changed, err
 		}
+		changed = true
 	}
 
-	// Update user in a team
-	for _, operation := range updateOperation {
-		team := operation.(map[string]interface{})
-		teamID := team["id"].(int)
-		isAdmin := team["is_admin"].(bool)
-		user, _ := c.GetUserForTeam(userEmail, teamID) //userID is 0 because new state could not preserve the ID
-		log.Printf("User %s with ID %d it will be updated in team %d with admin value %t", userEmail, user.ID, teamID, isAdmin)
-		err := c.UpdateUser(user.ID, isAdmin, teamID)
-		if err != nil {
-			return err
+	for teamID, team := range planTeamsByID {
+		isAdmin := team.IsAdmin.ValueBool()
+		stateTeam, ok := stateTeamsByID[teamID]
+
+		// Add user to a team
+		if !ok {
+			log.Printf("User %s will be invited to team %d with admin to %t", userEmail, teamID, isAdmin)
+			err := r.client.WithContext(ctx).CreateUser(userEmail, isAdmin, int(teamID))
+			if err != nil {
+				return changed, err
+			}
+			changed = true
+			continue
+		}
+
+		// Update user in a team
+		if stateTeam.IsAdmin.ValueBool() != isAdmin {
+			userID := int(stateTeam.UserID.ValueInt64())
+			log.Printf("User %s with ID %d will be updated in team %d with admin value %t", userEmail, userID, teamID, isAdmin)
+			err := r.client.WithContext(ctx).UpdateUser(userID, isAdmin, int(teamID))
+			if err != nil {
+				return changed, err
+			}
+			changed = true
 		}
 	}
 
-	return nil
+	return changed, nil
 }
 
-func calculateRealDifference(oldState *schema.Set, newState *schema.Set) *schema.Set {
-	updateOperation := schema.NewSet(oldState.F, []interface{}{})
+func parseUserImportID(id string) (string, []int64, error) {
+	var teamIDs []int64
 
-	for _, os := range oldState.List() {
-		teamRem := os.(map[string]interface{})
-		teamIDRem := teamRem["id"].(int)
-		for _, ns := range newState.List() {
-			teamAdd := ns.(map[string]interface{})
-			teamIDAdd := teamAdd["id"].(int)
-			if teamIDAdd == teamIDRem { // It is s an upate operation
-				updateOperation.Add(ns)
-				oldState.Remove(os)
-				newState.Remove(ns)
-			}
+	parts := strings.SplitN(id, ":", 2)
+	userEmail := parts[0]
+	if userEmail == "" {
+		return "", nil, fmt.Errorf("unexpected format of ID (%s), expected email or email:team_id[,team_id]", id)
+	}
+	if len(parts) == 1 {
+		return userEmail, teamIDs, nil
+	}
+
+	for _, teamID := range strings.Split(parts[1], ",") {
+		parsedTeamID, err := strconv.ParseInt(strings.TrimSpace(teamID), 10, 64)
+		if err != nil {
+			return "", nil, fmt.Errorf("invalid team_id in ID (%s): %s", id, err)
 		}
+		teamIDs = append(teamIDs, parsedTeamID)
 	}
-	return updateOperation
+
+	return userEmail, teamIDs, nil
 }
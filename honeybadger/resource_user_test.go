@@ -1,10 +1,13 @@
 package honeybadger
 
 import (
+	"context"
 	"fmt"
-	"strconv"
 	"testing"
 
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
@@ -17,27 +20,118 @@ func TestAccHoneybadgerUserBasic(t *testing.T) {
 	teamID := 1234
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckHoneybadgerUserDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckHoneybadgerUserDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckHoneybadgerUserConfigBasic(email, isAdmin, teamID),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckHoneybadgerUserExists("honeybadger_user.test"),
+					resource.TestCheckResourceAttr("honeybadger_user.test", "team.#", "1"),
 				),
 			},
+			{
+				ResourceName:            "honeybadger_user.test",
+				ImportState:             true,
+				ImportStateId:           fmt.Sprintf("%s:%d", email, teamID),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
 		},
 	})
 }
+func TestParseUserImportID(t *testing.T) {
+	cases := []struct {
+		id      string
+		email   string
+		teamIDs []int64
+		isError bool
+	}{
+		{id: "test.sequra@sequra.es", email: "test.sequra@sequra.es"},
+		{id: "test.sequra@sequra.es:1234", email: "test.sequra@sequra.es", teamIDs: []int64{1234}},
+		{id: "test.sequra@sequra.es:1234,5678", email: "test.sequra@sequra.es", teamIDs: []int64{1234, 5678}},
+		{id: "test.sequra@sequra.es:backend", isError: true},
+		{id: ":1234", isError: true},
+	}
+
+	for _, c := range cases {
+		email, teamIDs, err := parseUserImportID(c.id)
+		if c.isError {
+			if err == nil {
+				t.Fatalf("expected an error for ID %s", c.id)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		if email != c.email || fmt.Sprint(teamIDs) != fmt.Sprint(c.teamIDs) {
+			t.Fatalf("ID %s parsed as %s %v", c.id, email, teamIDs)
+		}
+	}
+}
+
+func TestUserValidateConfig(t *testing.T) {
+	ctx := context.Background()
+	r := NewUserResource().(*userResource)
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	teamType := objectType.AttributeTypes["team"].(tftypes.Set).ElementType.(tftypes.Object)
+
+	cases := []struct {
+		teamIDs []int64
+		isError bool
+	}{
+		{teamIDs: []int64{1234}},
+		{teamIDs: []int64{1234, 5678}},
+		{teamIDs: []int64{1234, 1234}, isError: true},
+	}
+
+	for _, c := range cases {
+		var teams []tftypes.Value
+		for i, teamID := range c.teamIDs {
+			// Duplicated teams only make it into the set when another attribute differs
+			teams = append(teams, tftypes.NewValue(teamType, map[string]tftypes.Value{
+				"id":       tftypes.NewValue(tftypes.Number, teamID),
+				"is_admin": tftypes.NewValue(tftypes.Bool, i%2 == 0),
+				"user_id":  tftypes.NewValue(tftypes.Number, nil),
+			}))
+		}
+
+		config := tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+				"id":           tftypes.NewValue(tftypes.String, nil),
+				"last_updated": tftypes.NewValue(tftypes.String, nil),
+				"email":        tftypes.NewValue(tftypes.String, "test.sequra@sequra.es"),
+				"team":         tftypes.NewValue(objectType.AttributeTypes["team"], teams),
+				"timeouts":     tftypes.NewValue(objectType.AttributeTypes["timeouts"], nil),
+			}),
+		}
+
+		var resp fwresource.ValidateConfigResponse
+		r.ValidateConfig(ctx, fwresource.ValidateConfigRequest{Config: config}, &resp)
+		if resp.Diagnostics.HasError() != c.isError {
+			t.Fatalf("teams %v: unexpected diagnostics %v", c.teamIDs, resp.Diagnostics)
+		}
+	}
+}
+
 func testAccCheckHoneybadgerUserConfigBasic(email string, isAdmin bool, teamID int) string {
 	return fmt.Sprintf(`
 	resource "honeybadger_user" "test" {
-		email = %s
-		admin = %t
- 		team_id = [%d]
+		email = "%s"
+		team = [
+			{
+				id       = %d
+				is_admin = %t
+			},
+		]
 	}
-	`, email, isAdmin, teamID)
+	`, email, teamID, isAdmin)
 }
 
 func testAccCheckHoneybadgerUserDestroy(s *terraform.State) error {
@@ -48,18 +142,12 @@ func testAccCheckHoneybadgerUserDestroy(s *terraform.State) error {
 			continue
 		}
 
-		userID := rs.Primary.ID
-		teams := rs.Primary.Attributes["team_id"]
-
-		useridToString, err := strconv.Atoi(userID)
+		userTeams, err := c.GetUserFromTeams(rs.Primary.ID)
 		if err != nil {
 			return err
 		}
-		for _, team := range teams {
-			err = c.DeleteUser(useridToString, int(team))
-			if err != nil {
-				return err
-			}
+		if len(userTeams) > 0 {
+			return fmt.Errorf("User %s still belongs to %d teams", rs.Primary.ID, len(userTeams))
 		}
 	}
 
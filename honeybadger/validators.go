@@ -0,0 +1,18 @@
+package honeybadger
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// emailRegexp - Only catches obvious typos, the API has the last word on which emails it accepts
+var emailRegexp = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`)
+
+const emailRegexpMessage = "must be a valid email address"
+
+// validateEmail - SDK counterpart of the framework email validators
+var validateEmail = validation.StringMatch(emailRegexp, emailRegexpMessage)
+
+// projectLanguages - Languages Honeybadger accepts for a project
+var projectLanguages = []string{"js", "elixir", "golang", "java", "node", "php", "python", "ruby", "other"}
@@ -0,0 +1,26 @@
+package honeybadger
+
+import (
+	"testing"
+)
+
+func TestValidateEmail(t *testing.T) {
+	cases := []struct {
+		email   string
+		isError bool
+	}{
+		{email: "test.sequra@sequra.es"},
+		{email: "test+alerts@sequra.es"},
+		{email: "test.sequra", isError: true},
+		{email: "test.sequra@sequra", isError: true},
+		{email: "test sequra@sequra.es", isError: true},
+		{email: "", isError: true},
+	}
+
+	for _, c := range cases {
+		_, errs := validateEmail(c.email, "email")
+		if (len(errs) > 0) != c.isError {
+			t.Fatalf("email %q: unexpected errors %v", c.email, errs)
+		}
+	}
+}